@@ -0,0 +1,166 @@
+// Package credentials hashes and checks Admin and User passwords.
+package credentials
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidHash = errors.New("credentials: invalid password hash")
+	// ErrUnsupportedHash means the stored value looks like a hash this package cannot verify, such as an
+	// unsalted MD5 or SHA digest. The password has to be reset.
+	ErrUnsupportedHash = errors.New("credentials: unsupported password hash")
+)
+
+// Limits on the argon2id parameters read back from a stored hash. A stored row is not trusted input:
+// p=0 panics in argon2 and a large m allocates that many KiB on every login attempt.
+const (
+	maxMemory      = 256 * 1024
+	maxIterations  = 32
+	maxParallelism = 16
+	minSaltLength  = 8
+	minKeyLength   = 16
+	maxKeyLength   = 64
+)
+
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams follows the OWASP argon2id recommendation of 64 MiB, 3 passes and 2 lanes.
+var DefaultParams = Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hash returns an argon2id hash in the PHC string format, e.g. $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>.
+func Hash(password string) (string, error) {
+	return HashWithParams(password, DefaultParams)
+}
+
+func HashWithParams(password string, p Params) (string, error) {
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify checks a password against a stored hash. needsRehash is true when the password matched a legacy
+// bcrypt or cleartext value, or an argon2id hash with weaker parameters than DefaultParams, in which case the
+// caller should store Hash(password) in place of the old value before finishing the login.
+// A value that looks like an unsupported hash fails with ErrUnsupportedHash instead of being compared as
+// cleartext, where typing the stored digest itself would log in.
+func Verify(password, encoded string) (ok bool, needsRehash bool, err error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		p, salt, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return false, false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return false, false, nil
+		}
+		return true, p.Memory < DefaultParams.Memory || p.Iterations < DefaultParams.Iterations || p.Parallelism < DefaultParams.Parallelism, nil
+	case isBcrypt(encoded):
+		if err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password)); err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return false, false, nil
+			}
+			return false, false, err
+		}
+		return true, true, nil
+	case encoded == "":
+		return false, false, ErrInvalidHash
+	case looksHashed(encoded):
+		return false, false, ErrUnsupportedHash
+	default:
+		// Rows created before hashing was introduced hold the password itself.
+		if subtle.ConstantTimeCompare([]byte(password), []byte(encoded)) != 1 {
+			return false, false, nil
+		}
+		return true, true, nil
+	}
+}
+
+// NeedsRehash reports whether a stored value should be replaced by a fresh argon2id hash.
+func NeedsRehash(encoded string) bool {
+	if !strings.HasPrefix(encoded, "$argon2id$") {
+		return true
+	}
+	p, _, _, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return p.Memory < DefaultParams.Memory || p.Iterations < DefaultParams.Iterations || p.Parallelism < DefaultParams.Parallelism
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+// looksHashed reports whether a stored value is a crypt style "$id$..." string or a hex digest of a
+// common length (MD5, SHA-1, SHA-224, SHA-256, SHA-384 and SHA-512), rather than a cleartext password.
+func looksHashed(encoded string) bool {
+	if strings.HasPrefix(encoded, "$") || strings.HasPrefix(encoded, "{") {
+		return true
+	}
+	switch len(encoded) {
+	case 32, 40, 56, 64, 96, 128:
+		_, err := hex.DecodeString(encoded)
+		return err == nil
+	}
+	return false
+}
+
+func decodeArgon2id(encoded string) (Params, []byte, []byte, error) {
+	var p Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, ErrInvalidHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if p.Parallelism < 1 || p.Parallelism > maxParallelism || p.Iterations < 1 || p.Iterations > maxIterations ||
+		p.Memory < 8*uint32(p.Parallelism) || p.Memory > maxMemory {
+		return p, nil, nil, ErrInvalidHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) < minSaltLength {
+		return p, nil, nil, ErrInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) < minKeyLength || len(key) > maxKeyLength {
+		return p, nil, nil, ErrInvalidHash
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
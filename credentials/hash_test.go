@@ -0,0 +1,136 @@
+package credentials
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var weakParams = Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashRoundTrip(t *testing.T) {
+	encoded, err := Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, needsRehash, err := Verify("correct horse", encoded)
+	if err != nil || !ok || needsRehash {
+		t.Fatalf("expected a match without rehash, got %v %v %v", ok, needsRehash, err)
+	}
+	if ok, _, _ := Verify("wrong horse", encoded); ok {
+		t.Fatal("expected a mismatch")
+	}
+	if NeedsRehash(encoded) {
+		t.Fatal("a hash with DefaultParams must not need a rehash")
+	}
+}
+
+func TestWeakArgon2idNeedsRehash(t *testing.T) {
+	encoded, err := HashWithParams("correct horse", weakParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, needsRehash, err := Verify("correct horse", encoded)
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("expected a match that needs a rehash, got %v %v %v", ok, needsRehash, err)
+	}
+	if !NeedsRehash(encoded) {
+		t.Fatal("expected NeedsRehash for weak parameters")
+	}
+}
+
+func TestBcryptUpgrade(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, needsRehash, err := Verify("correct horse", string(legacy))
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("expected a bcrypt match that needs a rehash, got %v %v %v", ok, needsRehash, err)
+	}
+	if ok, _, err := Verify("wrong horse", string(legacy)); ok || err != nil {
+		t.Fatalf("expected a plain mismatch, got %v %v", ok, err)
+	}
+}
+
+func TestCleartextLegacy(t *testing.T) {
+	ok, needsRehash, err := Verify("hunter22", "hunter22")
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("expected a cleartext match that needs a rehash, got %v %v %v", ok, needsRehash, err)
+	}
+}
+
+func TestUnsupportedDigestIsNotCleartext(t *testing.T) {
+	for _, stored := range []string{
+		"5f4dcc3b5aa765d61d327deb882cf99b",         // md5("password")
+		"5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8", // sha1("password")
+		"$1$saltsalt$qjXMvbEw8oaL.CzflDugX/",
+		"{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=",
+	} {
+		ok, _, err := Verify(stored, stored)
+		if ok || !errors.Is(err, ErrUnsupportedHash) {
+			t.Errorf("%s: typing the stored digest must not log in, got %v %v", stored, ok, err)
+		}
+	}
+}
+
+func TestMalformedHashes(t *testing.T) {
+	for _, stored := range []string{
+		"",
+		"$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHQ",
+		"$argon2id$v=18$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+		"$argon2id$v=19$m=65536,t=3,p=0$c2FsdHNhbHRzYWx0$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+		"$argon2id$v=19$m=4194304,t=3,p=2$c2FsdHNhbHRzYWx0$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+		"$argon2id$v=19$m=65536,t=1000,p=2$c2FsdHNhbHRzYWx0$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+		"$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+		"$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHRzYWx0$c2FsdA",
+		"$argon2id$v=19$m=65536,t=3,p=2$!!!$c2FsdHNhbHRzYWx0c2FsdHNhbHRzYWx0c2FsdHNhbHQ",
+	} {
+		if ok, _, err := Verify("password", stored); ok || !errors.Is(err, ErrInvalidHash) {
+			t.Errorf("%q: expected ErrInvalidHash, got %v %v", stored, ok, err)
+		}
+		if !NeedsRehash(stored) {
+			t.Errorf("%q: a malformed hash must need a rehash", stored)
+		}
+	}
+}
+
+func TestCheckWithBreachedList(t *testing.T) {
+	breached, err := LoadBreachedList("testdata/breached.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breached.Len() != 3 {
+		t.Fatalf("expected 3 entries, got %d", breached.Len())
+	}
+	policy := DefaultPolicy("ADMIN")
+
+	cases := []struct {
+		password   string
+		violations []string
+	}{
+		{"password", []string{"BREACHED"}},
+		{"123456", []string{"MIN_LENGTH", "BREACHED"}},
+		{"letmein123", []string{"BREACHED"}},
+		{"xadmin01x", []string{"USERNAME"}},
+		{"Tr0ub4dor&3", nil},
+	}
+	for _, c := range cases {
+		got := Check(policy, "admin01", c.password, breached)
+		if got.IsValid != (len(c.violations) == 0) || len(got.Violations) != len(c.violations) {
+			t.Errorf("%s: got %+v, want %v", c.password, got, c.violations)
+			continue
+		}
+		for i := range c.violations {
+			if got.Violations[i] != c.violations[i] {
+				t.Errorf("%s: got %v, want %v", c.password, got.Violations, c.violations)
+			}
+		}
+	}
+
+	if got := Check(model.PasswordPolicy{CheckBreached: true}, "", "password", nil); !got.IsValid {
+		t.Fatalf("a missing list must not fail the check, got %+v", got)
+	}
+}
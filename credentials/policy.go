@@ -0,0 +1,137 @@
+package credentials
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// DefaultPolicy applies when a tenant has no PasswordPolicy row for the actor type.
+func DefaultPolicy(actorType string) model.PasswordPolicy {
+	return model.PasswordPolicy{
+		ActorType:        actorType,
+		MinLength:        8,
+		MaxLength:        128,
+		CheckBreached:    true,
+		DisallowUsername: true,
+	}
+}
+
+// BreachedList is a set of known breached passwords, stored as upper-case SHA-1 hex digests.
+type BreachedList struct {
+	hashes map[string]struct{}
+}
+
+// LoadBreachedList reads a local file with one entry per line. An entry is either a SHA-1 hex digest,
+// optionally followed by ":count" as in the Have I Been Pwned dumps, or a cleartext password.
+// Empty lines and lines starting with # are ignored.
+func LoadBreachedList(path string) (*BreachedList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	list := &BreachedList{hashes: map[string]struct{}{}}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if digest, _, _ := strings.Cut(line, ":"); isSha1Hex(digest) {
+			list.hashes[strings.ToUpper(digest)] = struct{}{}
+			continue
+		}
+		list.hashes[sha1Hex(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (l *BreachedList) Contains(password string) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := l.hashes[sha1Hex(password)]
+	return ok
+}
+
+func (l *BreachedList) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.hashes)
+}
+
+// Check validates a new password against the policy. breached may be nil when no list is configured.
+func Check(policy model.PasswordPolicy, username, password string, breached *BreachedList) model.PasswordCheckResponse {
+	var violations []string
+	length := utf8.RuneCountInString(password)
+	if policy.MinLength > 0 && length < policy.MinLength {
+		violations = append(violations, "MIN_LENGTH")
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		violations = append(violations, "MAX_LENGTH")
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if policy.RequireUpper && !hasUpper {
+		violations = append(violations, "UPPER")
+	}
+	if policy.RequireLower && !hasLower {
+		violations = append(violations, "LOWER")
+	}
+	if policy.RequireDigit && !hasDigit {
+		violations = append(violations, "DIGIT")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		violations = append(violations, "SYMBOL")
+	}
+	if policy.DisallowUsername && username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		violations = append(violations, "USERNAME")
+	}
+
+	isBreached := policy.CheckBreached && breached.Contains(password)
+	if isBreached {
+		violations = append(violations, "BREACHED")
+	}
+	return model.PasswordCheckResponse{
+		IsValid:    len(violations) == 0,
+		IsBreached: isBreached,
+		Violations: violations,
+	}
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSha1Hex(s string) bool {
+	if len(s) != 40 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
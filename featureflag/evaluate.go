@@ -0,0 +1,119 @@
+// Package featureflag evaluates FeatureFlag rules for a tenant and member.
+package featureflag
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"gorm.io/gorm"
+)
+
+// Evaluate resolves the value of one flag. The first rule by Priority that matches the tenant, the
+// member tier and the rollout bucket wins. Without a match, the tenant's BotAccountConfig value for
+// ConfigKey applies when it parses as ValueType, and DefaultValue otherwise. configs is the tenant's
+// BotAccountConfig as returned by ConfigValues and may be nil.
+func Evaluate(flag model.FeatureFlag, rules []model.FeatureFlagRule, configs map[string]string, req model.FeatureFlagEvaluateRequest) model.FeatureFlagEvaluateResponse {
+	res := model.FeatureFlagEvaluateResponse{
+		FlagKey:   flag.FlagKey,
+		ValueType: flag.ValueType,
+		Value:     flag.DefaultValue,
+		Source:    "DEFAULT",
+		Version:   flag.Version,
+	}
+	if !flag.IsEnabled {
+		res.Source = "DISABLED"
+		return res
+	}
+
+	sorted := append([]model.FeatureFlagRule(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+	for _, rule := range sorted {
+		if !matches(flag.FlagKey, rule, req) {
+			continue
+		}
+		id := rule.Id
+		res.Value, res.RuleId, res.Source = rule.Value, &id, "RULE"
+		return res
+	}
+
+	if flag.ConfigKey != "" {
+		if raw, ok := configs[flag.ConfigKey]; ok {
+			if value, ok := Normalize(flag.ValueType, raw); ok {
+				res.Value, res.Source = value, "CONFIG"
+			}
+		}
+	}
+	return res
+}
+
+func matches(flagKey string, rule model.FeatureFlagRule, req model.FeatureFlagEvaluateRequest) bool {
+	if rule.TargetTenantId != nil && *rule.TargetTenantId != req.TenantId {
+		return false
+	}
+	if rule.MemberTier != "" && rule.MemberTier != req.MemberTier {
+		return false
+	}
+	return Bucket(flagKey, req.UserId) < rule.Percentage
+}
+
+// Bucket places a user in 0-99 for percentage rollouts. It depends on the flag key as well, so the
+// same users are not always the first to get every new flag.
+func Bucket(flagKey string, userId int64) int {
+	h := fnv.New32a()
+	h.Write([]byte(flagKey))
+	h.Write([]byte(":"))
+	h.Write([]byte(strconv.FormatInt(userId, 10)))
+	return int(h.Sum32() % 100)
+}
+
+// Normalize parses a raw value as valueType and returns it in the form flags store. BOOL accepts the
+// legacy toggle spellings found in BotAccountConfig and Settingweb, such as 1, on, yes and ACTIVE.
+func Normalize(valueType, raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	switch valueType {
+	case "BOOL":
+		switch strings.ToLower(raw) {
+		case "true", "1", "on", "yes", "y", "active", "enable", "enabled":
+			return "true", true
+		case "false", "0", "off", "no", "n", "deactive", "inactive", "disable", "disabled":
+			return "false", true
+		}
+		return "", false
+	case "INT":
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatInt(v, 10), true
+	case "FLOAT":
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case "STRING":
+		return raw, true
+	}
+	return "", false
+}
+
+// ConfigValues indexes BotAccountConfig rows by ConfigKey.
+func ConfigValues(rows []model.BotAccountConfig) map[string]string {
+	out := make(map[string]string, len(rows))
+	for _, row := range rows {
+		out[row.ConfigKey] = row.ConfigVal
+	}
+	return out
+}
+
+// LoadConfigValues reads the BotAccountConfig rows of the tenant in db's context.
+func LoadConfigValues(db *gorm.DB) (map[string]string, error) {
+	var rows []model.BotAccountConfig
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return ConfigValues(rows), nil
+}
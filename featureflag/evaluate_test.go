@@ -0,0 +1,104 @@
+package featureflag
+
+import (
+	"testing"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+var autoWithdraw = model.FeatureFlag{FlagKey: "auto_withdraw", ConfigKey: "auto_withdraw", ValueType: "BOOL", DefaultValue: "false", IsEnabled: true, Version: 3}
+
+func TestEvaluateRules(t *testing.T) {
+	rules := []model.FeatureFlagRule{
+		{Id: 2, Priority: 2, MemberTier: "VIP", Percentage: 100, Value: "true"},
+		{Id: 1, Priority: 1, TargetTenantId: int64Ptr(8), Percentage: 100, Value: "false"},
+	}
+	configs := map[string]string{"auto_withdraw": "1"}
+
+	cases := []struct {
+		name   string
+		req    model.FeatureFlagEvaluateRequest
+		value  string
+		source string
+		ruleId int64
+	}{
+		{"tenant rule first", model.FeatureFlagEvaluateRequest{TenantId: 8, MemberTier: "VIP"}, "false", "RULE", 1},
+		{"tier rule", model.FeatureFlagEvaluateRequest{TenantId: 7, MemberTier: "VIP"}, "true", "RULE", 2},
+		{"legacy config", model.FeatureFlagEvaluateRequest{TenantId: 7, MemberTier: "GOLD"}, "true", "CONFIG", 0},
+	}
+	for _, c := range cases {
+		res := Evaluate(autoWithdraw, rules, configs, c.req)
+		if res.Value != c.value || res.Source != c.source || res.Version != 3 {
+			t.Errorf("%s: got %+v", c.name, res)
+			continue
+		}
+		if c.ruleId != 0 && (res.RuleId == nil || *res.RuleId != c.ruleId) {
+			t.Errorf("%s: expected rule %d, got %v", c.name, c.ruleId, res.RuleId)
+		}
+	}
+}
+
+func TestEvaluateDisabledAndDefault(t *testing.T) {
+	flag := autoWithdraw
+	flag.IsEnabled = false
+	rules := []model.FeatureFlagRule{{Id: 1, Percentage: 100, Value: "true"}}
+	if res := Evaluate(flag, rules, nil, model.FeatureFlagEvaluateRequest{}); res.Source != "DISABLED" || res.Value != "false" {
+		t.Fatalf("a disabled flag must return its default, got %+v", res)
+	}
+	if res := Evaluate(autoWithdraw, nil, map[string]string{"auto_withdraw": "maybe"}, model.FeatureFlagEvaluateRequest{}); res.Source != "DEFAULT" {
+		t.Fatalf("an unparsable config value must fall back to the default, got %+v", res)
+	}
+}
+
+func TestEvaluatePercentageRollout(t *testing.T) {
+	rules := []model.FeatureFlagRule{{Id: 1, Percentage: 30, Value: "true"}}
+	on := 0
+	for userId := int64(1); userId <= 1000; userId++ {
+		res := Evaluate(autoWithdraw, rules, nil, model.FeatureFlagEvaluateRequest{UserId: userId})
+		if res.Source == "RULE" {
+			on++
+		}
+		if again := Evaluate(autoWithdraw, rules, nil, model.FeatureFlagEvaluateRequest{UserId: userId}); again.Source != res.Source {
+			t.Fatalf("user %d must get a stable bucket", userId)
+		}
+	}
+	if on < 250 || on > 350 {
+		t.Fatalf("expected about 30%% of users, got %d of 1000", on)
+	}
+
+	rules[0].Percentage = 0
+	if res := Evaluate(autoWithdraw, rules, nil, model.FeatureFlagEvaluateRequest{UserId: 1}); res.Source == "RULE" {
+		t.Fatal("a 0% rule must match nobody")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		valueType, raw, want string
+		ok                   bool
+	}{
+		{"BOOL", "ACTIVE", "true", true},
+		{"BOOL", "off", "false", true},
+		{"BOOL", "2", "", false},
+		{"INT", " 05 ", "5", true},
+		{"INT", "1.5", "", false},
+		{"FLOAT", "1.50", "1.5", true},
+		{"STRING", "line", "line", true},
+		{"JSON", "{}", "", false},
+	}
+	for _, c := range cases {
+		got, ok := Normalize(c.valueType, c.raw)
+		if got != c.want || ok != c.ok {
+			t.Errorf("Normalize(%s, %q) = %q %v, want %q %v", c.valueType, c.raw, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestConfigValues(t *testing.T) {
+	got := ConfigValues([]model.BotAccountConfig{{ConfigKey: "auto_withdraw", ConfigVal: "1"}, {ConfigKey: "otp_register", ConfigVal: "0"}})
+	if len(got) != 2 || got["otp_register"] != "0" {
+		t.Fatalf("unexpected values %v", got)
+	}
+}
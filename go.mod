@@ -2,9 +2,13 @@ module github.com/Cyber-Rich-Digital/game-package
 
 go 1.20
 
-require gorm.io/gorm v1.24.6
+require (
+	golang.org/x/crypto v0.14.0
+	gorm.io/gorm v1.24.6
+)
 
 require (
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/sys v0.13.0 // indirect
 )
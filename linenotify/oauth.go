@@ -0,0 +1,214 @@
+// Package linenotify runs the LINE Notify OAuth authorization-code flow for LinenotifyGame and calls
+// the token status and revoke APIs.
+package linenotify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+const (
+	BotBaseUrl = "https://notify-bot.line.me"
+	ApiBaseUrl = "https://notify-api.line.me"
+)
+
+var (
+	ErrStateInvalid = errors.New("linenotify: invalid oauth state")
+	ErrStateExpired = errors.New("linenotify: oauth state expired")
+	ErrStateUsed    = errors.New("linenotify: oauth state already used")
+)
+
+// APIError is a non 2xx answer from LINE. A 401 from Status or Revoke means the token is no longer valid.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("linenotify: status %d: %s", e.StatusCode, e.Message)
+}
+
+// Client talks to LINE Notify. Point BotUrl and ApiUrl at a local server in tests.
+type Client struct {
+	BotUrl   string
+	ApiUrl   string
+	HTTP     *http.Client
+	StateTTL time.Duration
+	Now      func() time.Time
+}
+
+func NewClient() *Client {
+	return &Client{
+		BotUrl:   BotBaseUrl,
+		ApiUrl:   ApiBaseUrl,
+		HTTP:     &http.Client{Timeout: 10 * time.Second},
+		StateTTL: 10 * time.Minute,
+		Now:      time.Now,
+	}
+}
+
+// Authorize creates a one-time state bound to the user and the authorize url to redirect them to.
+// Store the returned LinenotifyOauthState; LinenotifyGame.State is not used.
+func (c *Client) Authorize(game model.LinenotifyGame, req model.LinenotifyAuthorizeRequest) (model.LinenotifyOauthState, model.LinenotifyAuthorizeResponse, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return model.LinenotifyOauthState{}, model.LinenotifyAuthorizeResponse{}, err
+	}
+	now := c.Now()
+	state := model.LinenotifyOauthState{
+		TenantId:         game.TenantId,
+		State:            base64.RawURLEncoding.EncodeToString(b),
+		UserId:           req.UserId,
+		LinenotifyGameId: game.Id,
+		TypeNotifyId:     req.TypeNotifyId,
+		ExpiredAt:        now.Add(c.StateTTL),
+		CreatedAt:        now,
+	}
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", game.ClientId)
+	query.Set("redirect_uri", game.RedirectUri)
+	query.Set("scope", "notify")
+	query.Set("state", state.State)
+	if game.ResponseType == "form_post" {
+		query.Set("response_mode", "form_post")
+	}
+	return state, model.LinenotifyAuthorizeResponse{
+		AuthorizeUrl: strings.TrimRight(c.BotUrl, "/") + "/oauth/authorize?" + query.Encode(),
+		State:        state.State,
+		ExpiredAt:    state.ExpiredAt,
+	}, nil
+}
+
+// ValidateState checks the state LINE redirected back with against the stored row, which may be nil
+// when no row matched. userId is the user of the current request.
+func (c *Client) ValidateState(stored *model.LinenotifyOauthState, redirect model.LineNotifyRedirectReponse, userId int64) error {
+	if stored == nil || redirect.State == "" || subtle.ConstantTimeCompare([]byte(stored.State), []byte(redirect.State)) != 1 {
+		return ErrStateInvalid
+	}
+	if stored.UserId != userId {
+		return ErrStateInvalid
+	}
+	if stored.UsedAt != nil {
+		return ErrStateUsed
+	}
+	if !c.Now().Before(stored.ExpiredAt) {
+		return ErrStateExpired
+	}
+	return nil
+}
+
+// Callback validates the redirect, marks the state used and exchanges the code. The caller saves the
+// updated state and the returned LineNoifyUsergame in one transaction.
+func (c *Client) Callback(ctx context.Context, game model.LinenotifyGame, stored *model.LinenotifyOauthState, redirect model.LineNotifyRedirectReponse, userId int64) (model.LineNoifyUsergame, error) {
+	if err := c.ValidateState(stored, redirect, userId); err != nil {
+		return model.LineNoifyUsergame{}, err
+	}
+	if stored.LinenotifyGameId != game.Id {
+		return model.LineNoifyUsergame{}, ErrStateInvalid
+	}
+	now := c.Now()
+	stored.UsedAt = &now
+
+	token, err := c.Exchange(ctx, game, redirect.Code)
+	if err != nil {
+		return model.LineNoifyUsergame{}, err
+	}
+	return model.LineNoifyUsergame{
+		TenantId:        stored.TenantId,
+		UserId:          stored.UserId,
+		TypeNotifyId:    stored.TypeNotifyId,
+		Token:           token,
+		Status:          "ACTIVE",
+		StatusCheckedAt: &now,
+		CreatedAt:       now,
+	}, nil
+}
+
+// Exchange trades an authorization code for an access token.
+func (c *Client) Exchange(ctx context.Context, game model.LinenotifyGame, code string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", game.RedirectUri)
+	form.Set("client_id", game.ClientId)
+	form.Set("client_secret", game.ClientSecret)
+
+	var res model.LinenotifyTokenResponse
+	if err := c.do(ctx, http.MethodPost, strings.TrimRight(c.BotUrl, "/")+"/oauth/token", "", form, &res); err != nil {
+		return "", err
+	}
+	if res.AccessToken == "" {
+		return "", &APIError{StatusCode: res.Status, Message: "no access token in response"}
+	}
+	return res.AccessToken, nil
+}
+
+// Status checks whether a token still works.
+func (c *Client) Status(ctx context.Context, token string) (model.LinenotifyStatusResponse, error) {
+	var res model.LinenotifyStatusResponse
+	err := c.do(ctx, http.MethodGet, strings.TrimRight(c.ApiUrl, "/")+"/api/status", token, nil, &res)
+	return res, err
+}
+
+// Revoke disconnects a token from the user's LINE account.
+func (c *Client) Revoke(ctx context.Context, token string) (model.LinenotifyRevokeResponse, error) {
+	var res model.LinenotifyRevokeResponse
+	err := c.do(ctx, http.MethodPost, strings.TrimRight(c.ApiUrl, "/")+"/api/revoke", token, url.Values{}, &res)
+	return res, err
+}
+
+func (c *Client) do(ctx context.Context, method, endpoint, token string, form url.Values, out interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("linenotify: request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(res.Body, 64*1024))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(b, &apiErr)
+		return &APIError{StatusCode: res.StatusCode, Message: apiErr.Message}
+	}
+	return json.Unmarshal(b, out)
+}
@@ -0,0 +1,181 @@
+package linenotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// fakeLine answers like notify-bot.line.me and notify-api.line.me for one client and one code.
+func fakeLine(t *testing.T) *httptest.Server {
+	t.Helper()
+	revoked := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.Method != http.MethodPost || r.PostForm.Get("grant_type") != "authorization_code" ||
+			r.PostForm.Get("client_id") != "client-1" || r.PostForm.Get("client_secret") != "s3cret-client" ||
+			r.PostForm.Get("redirect_uri") != "https://example.com/callback" || r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 400, "message": "invalid code"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 200, "message": "access_token is issued", "access_token": "s3cret-token"})
+	})
+	mux.HandleFunc("/api/status", func(w http.ResponseWriter, r *http.Request) {
+		if revoked || r.Header.Get("Authorization") != "Bearer s3cret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 401, "message": "Invalid access token"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 200, "message": "ok", "targetType": "USER", "target": "admin01"})
+	})
+	mux.HandleFunc("/api/revoke", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer s3cret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		revoked = true
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": 200, "message": "ok"})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testClient(srv *httptest.Server, now time.Time) *Client {
+	c := NewClient()
+	c.BotUrl = srv.URL
+	c.ApiUrl = srv.URL
+	c.HTTP = srv.Client()
+	c.Now = func() time.Time { return now }
+	return c
+}
+
+var testGame = model.LinenotifyGame{
+	Id:           3,
+	TenantId:     1,
+	ClientId:     "client-1",
+	ClientSecret: "s3cret-client",
+	RedirectUri:  "https://example.com/callback",
+}
+
+func TestAuthorize(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := testClient(fakeLine(t), now)
+	state, res, err := c.Authorize(testGame, model.LinenotifyAuthorizeRequest{LinenotifyGameId: 3, TypeNotifyId: "2", UserId: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.State) < 40 || state.State != res.State || state.UserId != 7 || state.TypeNotifyId != "2" ||
+		state.LinenotifyGameId != 3 || state.TenantId != 1 || !state.ExpiredAt.Equal(now.Add(10*time.Minute)) {
+		t.Fatalf("state = %+v", state)
+	}
+	u, err := url.Parse(res.AuthorizeUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if u.Path != "/oauth/authorize" || q.Get("response_type") != "code" || q.Get("client_id") != "client-1" ||
+		q.Get("redirect_uri") != testGame.RedirectUri || q.Get("scope") != "notify" || q.Get("state") != state.State {
+		t.Fatalf("authorize url = %s", res.AuthorizeUrl)
+	}
+	if strings.Contains(res.AuthorizeUrl, "s3cret") {
+		t.Fatal("authorize url contains the client secret")
+	}
+
+	other, _, _ := c.Authorize(testGame, model.LinenotifyAuthorizeRequest{UserId: 7})
+	if other.State == state.State {
+		t.Fatal("states repeat")
+	}
+}
+
+func TestCallback(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := fakeLine(t)
+	c := testClient(srv, now)
+	state, _, err := c.Authorize(testGame, model.LinenotifyAuthorizeRequest{LinenotifyGameId: 3, TypeNotifyId: "2", UserId: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := c.Callback(ctx, testGame, &state, model.LineNotifyRedirectReponse{Code: "good-code", State: "forged"}, 7); !errors.Is(err, ErrStateInvalid) {
+		t.Fatalf("forged state: %v", err)
+	}
+	if _, err := c.Callback(ctx, testGame, &state, model.LineNotifyRedirectReponse{Code: "good-code", State: state.State}, 8); !errors.Is(err, ErrStateInvalid) {
+		t.Fatalf("other user: %v", err)
+	}
+	if _, err := c.Callback(ctx, testGame, nil, model.LineNotifyRedirectReponse{Code: "good-code", State: state.State}, 7); !errors.Is(err, ErrStateInvalid) {
+		t.Fatalf("unknown state: %v", err)
+	}
+	late := testClient(srv, now.Add(11*time.Minute))
+	if _, err := late.Callback(ctx, testGame, &state, model.LineNotifyRedirectReponse{Code: "good-code", State: state.State}, 7); !errors.Is(err, ErrStateExpired) {
+		t.Fatalf("expired state: %v", err)
+	}
+
+	usergame, err := c.Callback(ctx, testGame, &state, model.LineNotifyRedirectReponse{Code: "good-code", State: state.State}, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usergame.Token != "s3cret-token" || usergame.UserId != 7 || usergame.TypeNotifyId != "2" || usergame.TenantId != 1 || usergame.Status != "ACTIVE" {
+		t.Fatalf("usergame = %+v", usergame)
+	}
+	if state.UsedAt == nil {
+		t.Fatal("state not marked used")
+	}
+	if _, err := c.Callback(ctx, testGame, &state, model.LineNotifyRedirectReponse{Code: "good-code", State: state.State}, 7); !errors.Is(err, ErrStateUsed) {
+		t.Fatalf("replayed state: %v", err)
+	}
+}
+
+func TestExchangeRejectedCode(t *testing.T) {
+	c := testClient(fakeLine(t), time.Now())
+	_, err := c.Exchange(context.Background(), testGame, "bad-code")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "invalid code" {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestStatusAndRevoke(t *testing.T) {
+	c := testClient(fakeLine(t), time.Now())
+	ctx := context.Background()
+
+	status, err := c.Status(ctx, "s3cret-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != 200 || status.TargetType != "USER" || status.Target != "admin01" {
+		t.Fatalf("status = %+v", status)
+	}
+	res, err := c.Revoke(ctx, "s3cret-token")
+	if err != nil || res.Status != 200 {
+		t.Fatalf("revoke = %+v, %v", res, err)
+	}
+	_, err = c.Status(ctx, "s3cret-token")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status after revoke: %v", err)
+	}
+}
+
+func TestTransportErrorHidesSecrets(t *testing.T) {
+	srv := fakeLine(t)
+	c := testClient(srv, time.Now())
+	srv.Close()
+	_, err := c.Exchange(context.Background(), testGame, "good-code")
+	if err == nil || strings.Contains(err.Error(), "s3cret") {
+		t.Fatalf("err = %v", err)
+	}
+}
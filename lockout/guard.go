@@ -0,0 +1,221 @@
+// Package lockout counts failed logins per username and per IP over a sliding window,
+// slows repeated attempts down and locks the key once the limit is reached.
+package lockout
+
+import (
+	"context"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+const (
+	KeyTypeUsername = "USERNAME"
+	KeyTypeIp       = "IP"
+)
+
+// DefaultSetting applies when a tenant has no LoginLockoutSetting row for the actor type.
+func DefaultSetting(actorType string) model.LoginLockoutSetting {
+	return model.LoginLockoutSetting{
+		ActorType:            actorType,
+		WindowMinutes:        15,
+		MaxFailedPerUsername: 5,
+		MaxFailedPerIp:       20,
+		LockMinutes:          15,
+		MaxLockMinutes:       1440,
+		LockResetDays:        7,
+		DelayBaseMs:          250,
+		DelayMaxMs:           5000,
+		NotifyOnLock:         true,
+	}
+}
+
+type Guard struct {
+	store   Store
+	setting model.LoginLockoutSetting
+	// OnLock is called after a key gets locked when the setting has NotifyOnLock.
+	OnLock func(ctx context.Context, lock model.LoginLockout)
+	Now    func() time.Time
+}
+
+func NewGuard(store Store, setting model.LoginLockoutSetting) *Guard {
+	return &Guard{store: store, setting: setting, Now: time.Now}
+}
+
+// Check tells the login handler whether to reject the attempt before verifying the password,
+// and how long to wait before answering.
+func (g *Guard) Check(ctx context.Context, tenantId int64, actorType, username, ip string) (model.LoginLockoutStatus, error) {
+	now := g.Now()
+	windowStart := now.Add(-g.window())
+	userKey, ipKey := g.keys(tenantId, actorType, username, ip)
+
+	var status model.LoginLockoutStatus
+	for _, key := range []Key{userKey, ipKey} {
+		if key.KeyValue == "" {
+			continue
+		}
+		lock, err := g.store.GetLock(ctx, key)
+		if err != nil {
+			return status, err
+		}
+		if lock != nil && lock.IsActive(now) {
+			status.IsLocked = true
+			if status.LockedUntil == nil || lock.LockedUntil.After(*status.LockedUntil) {
+				status.LockedUntil = lock.LockedUntil
+			}
+		}
+	}
+
+	count, err := g.store.CountFailures(ctx, userKey, windowStart)
+	if err != nil {
+		return status, err
+	}
+	g.fill(&status, count)
+	return status, nil
+}
+
+// RecordFailure counts a failed attempt against both the username and the IP and locks whichever reached its limit.
+func (g *Guard) RecordFailure(ctx context.Context, tenantId int64, actorType, username, ip string) (model.LoginLockoutStatus, error) {
+	now := g.Now()
+	windowStart := now.Add(-g.window())
+	userKey, ipKey := g.keys(tenantId, actorType, username, ip)
+
+	var status model.LoginLockoutStatus
+	limits := map[Key]int{userKey: g.setting.MaxFailedPerUsername, ipKey: g.setting.MaxFailedPerIp}
+	for _, key := range []Key{userKey, ipKey} {
+		if key.KeyValue == "" {
+			continue
+		}
+		count, err := g.store.AddFailure(ctx, key, now, windowStart)
+		if err != nil {
+			return status, err
+		}
+		if key == userKey {
+			g.fill(&status, count)
+		}
+		if limits[key] <= 0 || count < limits[key] {
+			continue
+		}
+		lock, err := g.lock(ctx, key, count, now)
+		if err != nil {
+			return status, err
+		}
+		status.IsLocked = true
+		if status.LockedUntil == nil || lock.LockedUntil.After(*status.LockedUntil) {
+			status.LockedUntil = lock.LockedUntil
+		}
+	}
+	return status, nil
+}
+
+// RecordSuccess clears the username counter. The IP counter is kept so one good account cannot reset a spray.
+func (g *Guard) RecordSuccess(ctx context.Context, tenantId int64, actorType, username string) error {
+	userKey, _ := g.keys(tenantId, actorType, username, "")
+	return g.store.ResetFailures(ctx, userKey)
+}
+
+// Unlock lifts an active lock and clears its counter. The lock count starts over as well, so the next
+// lock after an admin checked the account lasts LockMinutes again.
+func (g *Guard) Unlock(ctx context.Context, key Key, body model.LoginLockoutUnlockBody) error {
+	lock, err := g.store.GetLock(ctx, key)
+	if err != nil || lock == nil {
+		return err
+	}
+	if body.UnlockedAt.IsZero() {
+		body.UnlockedAt = g.Now()
+	}
+	lock.UnlockRemark = body.UnlockRemark
+	lock.UnlockedAt = &body.UnlockedAt
+	lock.UnlockedByUserId = body.UnlockedByUserId
+	lock.UnlockedByUsername = body.UnlockedByUsername
+	lock.LockCount = 0
+	lock.UpdatedAt = &body.UnlockedAt
+	if err := g.store.SaveLock(ctx, *lock); err != nil {
+		return err
+	}
+	return g.store.ResetFailures(ctx, key)
+}
+
+func (g *Guard) lock(ctx context.Context, key Key, count int, now time.Time) (model.LoginLockout, error) {
+	lock := model.LoginLockout{
+		TenantId:  key.TenantId,
+		ActorType: key.ActorType,
+		KeyType:   key.KeyType,
+		KeyValue:  key.KeyValue,
+		CreatedAt: now,
+	}
+	previous, err := g.store.GetLock(ctx, key)
+	if err != nil {
+		return lock, err
+	}
+	if previous != nil {
+		if previous.IsActive(now) {
+			return *previous, nil
+		}
+		lock = *previous
+		// A key that stayed unlocked for LockResetDays starts again from LockMinutes.
+		if g.setting.LockResetDays > 0 && previous.LockedUntil != nil &&
+			now.Sub(*previous.LockedUntil) >= time.Duration(g.setting.LockResetDays)*24*time.Hour {
+			lock.LockCount = 0
+		}
+	}
+
+	// Each repeated lock doubles the duration, up to MaxLockMinutes.
+	duration := time.Duration(g.setting.LockMinutes) * time.Minute
+	for i := 0; i < lock.LockCount; i++ {
+		duration *= 2
+		if g.setting.MaxLockMinutes > 0 && duration >= time.Duration(g.setting.MaxLockMinutes)*time.Minute {
+			duration = time.Duration(g.setting.MaxLockMinutes) * time.Minute
+			break
+		}
+	}
+	until := now.Add(duration)
+	lock.FailedCount = count
+	lock.LockCount++
+	lock.LockedAt = &now
+	lock.LockedUntil = &until
+	lock.UnlockRemark = ""
+	lock.UnlockedAt = nil
+	lock.UnlockedByUserId = 0
+	lock.UnlockedByUsername = ""
+	lock.UpdatedAt = &now
+	if err := g.store.SaveLock(ctx, lock); err != nil {
+		return lock, err
+	}
+	if g.setting.NotifyOnLock && g.OnLock != nil {
+		g.OnLock(ctx, lock)
+	}
+	return lock, nil
+}
+
+func (g *Guard) fill(status *model.LoginLockoutStatus, count int) {
+	status.FailedCount = count
+	if remain := g.setting.MaxFailedPerUsername - count; remain > 0 {
+		status.RemainCount = remain
+	}
+	status.DelayMs = g.delay(count)
+}
+
+// delay doubles DelayBaseMs for every failure after the first, capped at DelayMaxMs.
+func (g *Guard) delay(count int) int {
+	if count <= 0 || g.setting.DelayBaseMs <= 0 {
+		return 0
+	}
+	delay := g.setting.DelayBaseMs
+	for i := 1; i < count; i++ {
+		delay *= 2
+		if g.setting.DelayMaxMs > 0 && delay >= g.setting.DelayMaxMs {
+			return g.setting.DelayMaxMs
+		}
+	}
+	return delay
+}
+
+func (g *Guard) window() time.Duration {
+	return time.Duration(g.setting.WindowMinutes) * time.Minute
+}
+
+func (g *Guard) keys(tenantId int64, actorType, username, ip string) (Key, Key) {
+	return Key{TenantId: tenantId, ActorType: actorType, KeyType: KeyTypeUsername, KeyValue: username},
+		Key{TenantId: tenantId, ActorType: actorType, KeyType: KeyTypeIp, KeyValue: ip}
+}
@@ -0,0 +1,156 @@
+package lockout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+func newTestGuard(now *time.Time) (*Guard, *MemoryStore, *[]model.LoginLockout) {
+	store := NewMemoryStore()
+	setting := DefaultSetting("ADMIN")
+	setting.MaxFailedPerUsername = 3
+	setting.MaxFailedPerIp = 5
+	guard := NewGuard(store, setting)
+	guard.Now = func() time.Time { return *now }
+	var notified []model.LoginLockout
+	guard.OnLock = func(ctx context.Context, lock model.LoginLockout) {
+		notified = append(notified, lock)
+	}
+	return guard, store, &notified
+}
+
+func TestGuardLocksUsernameAfterLimit(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	guard, _, notified := newTestGuard(&now)
+
+	for i := 0; i < 2; i++ {
+		status, err := guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "1.1.1.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.IsLocked {
+			t.Fatalf("locked after %d failures", i+1)
+		}
+	}
+	status, _ := guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "1.1.1.1")
+	if !status.IsLocked || status.LockedUntil == nil || !status.LockedUntil.Equal(now.Add(15*time.Minute)) {
+		t.Fatalf("expected a 15 minute lock, got %+v", status)
+	}
+	if len(*notified) != 1 || (*notified)[0].KeyType != KeyTypeUsername {
+		t.Fatalf("expected one username lock notification, got %+v", *notified)
+	}
+
+	status, _ = guard.Check(ctx, 1, "ADMIN", "admin01", "2.2.2.2")
+	if !status.IsLocked {
+		t.Fatal("username lock must apply from another IP")
+	}
+	now = now.Add(16 * time.Minute)
+	status, _ = guard.Check(ctx, 1, "ADMIN", "admin01", "2.2.2.2")
+	if status.IsLocked {
+		t.Fatal("lock should expire")
+	}
+}
+
+func TestGuardSlidingWindowAndDelay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	guard, _, _ := newTestGuard(&now)
+
+	status, _ := guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "1.1.1.1")
+	if status.DelayMs != 250 || status.RemainCount != 2 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	status, _ = guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "1.1.1.1")
+	if status.DelayMs != 500 {
+		t.Fatalf("expected delay to double, got %d", status.DelayMs)
+	}
+
+	now = now.Add(20 * time.Minute)
+	status, _ = guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "1.1.1.1")
+	if status.FailedCount != 1 || status.IsLocked {
+		t.Fatalf("old failures should fall out of the window, got %+v", status)
+	}
+}
+
+func TestGuardRepeatedLockDoublesAndUnlock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	guard, store, _ := newTestGuard(&now)
+
+	for i := 0; i < 3; i++ {
+		guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "")
+	}
+	now = now.Add(16 * time.Minute)
+	var status model.LoginLockoutStatus
+	for i := 0; i < 3; i++ {
+		status, _ = guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "")
+	}
+	if !status.LockedUntil.Equal(now.Add(30 * time.Minute)) {
+		t.Fatalf("expected the second lock to last 30 minutes, got %v", status.LockedUntil)
+	}
+
+	key := Key{TenantId: 1, ActorType: "ADMIN", KeyType: KeyTypeUsername, KeyValue: "admin01"}
+	err := guard.Unlock(ctx, key, model.LoginLockoutUnlockBody{UnlockRemark: "verified by phone", UnlockedByUsername: "owner"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ = guard.Check(ctx, 1, "ADMIN", "admin01", "")
+	if status.IsLocked || status.FailedCount != 0 {
+		t.Fatalf("expected unlocked with a clean counter, got %+v", status)
+	}
+	if locks := store.Locks(); len(locks) != 1 || locks[0].LockCount != 0 || locks[0].UnlockedByUsername != "owner" {
+		t.Fatalf("unexpected lock rows %+v", locks)
+	}
+}
+
+func TestGuardLockCountResets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	guard, _, _ := newTestGuard(&now)
+	lockAgain := func() model.LoginLockoutStatus {
+		var status model.LoginLockoutStatus
+		for i := 0; i < 3; i++ {
+			status, _ = guard.RecordFailure(ctx, 1, "ADMIN", "admin01", "")
+		}
+		return status
+	}
+
+	lockAgain()
+	now = now.Add(16 * time.Minute)
+	lockAgain()
+	key := Key{TenantId: 1, ActorType: "ADMIN", KeyType: KeyTypeUsername, KeyValue: "admin01"}
+	if err := guard.Unlock(ctx, key, model.LoginLockoutUnlockBody{UnlockedByUsername: "owner"}); err != nil {
+		t.Fatal(err)
+	}
+	if status := lockAgain(); !status.LockedUntil.Equal(now.Add(15 * time.Minute)) {
+		t.Fatalf("expected the first lock after an unlock to last 15 minutes, got %v", status.LockedUntil)
+	}
+
+	now = now.Add(16 * time.Minute)
+	if status := lockAgain(); !status.LockedUntil.Equal(now.Add(30 * time.Minute)) {
+		t.Fatalf("expected a repeated lock to last 30 minutes, got %v", status.LockedUntil)
+	}
+	now = now.Add(30*time.Minute + 7*24*time.Hour)
+	if status := lockAgain(); !status.LockedUntil.Equal(now.Add(15 * time.Minute)) {
+		t.Fatalf("expected a lock after a quiet week to last 15 minutes, got %v", status.LockedUntil)
+	}
+}
+
+func TestGuardSuccessKeepsIpCounter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	guard, _, notified := newTestGuard(&now)
+
+	for _, username := range []string{"a", "b", "c", "d"} {
+		guard.RecordFailure(ctx, 1, "USER", username, "9.9.9.9")
+	}
+	guard.RecordSuccess(ctx, 1, "USER", "e")
+	status, _ := guard.RecordFailure(ctx, 1, "USER", "f", "9.9.9.9")
+	if !status.IsLocked || len(*notified) != 1 || (*notified)[0].KeyType != KeyTypeIp {
+		t.Fatalf("expected the IP to be locked, got %+v %+v", status, *notified)
+	}
+}
@@ -0,0 +1,104 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// Key identifies one counter, e.g. {1, "ADMIN", "USERNAME", "admin01"} or {1, "USER", "IP", "1.1.1.1"}.
+type Key struct {
+	TenantId  int64
+	ActorType string
+	KeyType   string
+	KeyValue  string
+}
+
+// Store keeps failure timestamps and lock rows. Implementations must be safe for concurrent use.
+type Store interface {
+	// AddFailure records a failure at the given time and returns the number of failures since windowStart.
+	AddFailure(ctx context.Context, key Key, at time.Time, windowStart time.Time) (int, error)
+	CountFailures(ctx context.Context, key Key, windowStart time.Time) (int, error)
+	ResetFailures(ctx context.Context, key Key) error
+	// GetLock returns the latest lock row for the key, or nil when it has never been locked.
+	GetLock(ctx context.Context, key Key) (*model.LoginLockout, error)
+	SaveLock(ctx context.Context, lock model.LoginLockout) error
+}
+
+type MemoryStore struct {
+	mu       sync.Mutex
+	failures map[Key][]time.Time
+	locks    map[Key]model.LoginLockout
+	nextId   int64
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		failures: map[Key][]time.Time{},
+		locks:    map[Key]model.LoginLockout{},
+	}
+}
+
+func (s *MemoryStore) AddFailure(ctx context.Context, key Key, at time.Time, windowStart time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[key] = append(prune(s.failures[key], windowStart), at)
+	return len(s.failures[key]), nil
+}
+
+func (s *MemoryStore) CountFailures(ctx context.Context, key Key, windowStart time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[key] = prune(s.failures[key], windowStart)
+	return len(s.failures[key]), nil
+}
+
+func (s *MemoryStore) ResetFailures(ctx context.Context, key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, key)
+	return nil
+}
+
+func (s *MemoryStore) GetLock(ctx context.Context, key Key) (*model.LoginLockout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lock, ok := s.locks[key]
+	if !ok {
+		return nil, nil
+	}
+	return &lock, nil
+}
+
+func (s *MemoryStore) SaveLock(ctx context.Context, lock model.LoginLockout) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lock.Id == 0 {
+		s.nextId++
+		lock.Id = s.nextId
+	}
+	key := Key{TenantId: lock.TenantId, ActorType: lock.ActorType, KeyType: lock.KeyType, KeyValue: lock.KeyValue}
+	s.locks[key] = lock
+	return nil
+}
+
+// Locks returns every lock row, for list endpoints backed by the memory store and for tests.
+func (s *MemoryStore) Locks() []model.LoginLockout {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]model.LoginLockout, 0, len(s.locks))
+	for _, lock := range s.locks {
+		list = append(list, lock)
+	}
+	return list
+}
+
+func prune(times []time.Time, windowStart time.Time) []time.Time {
+	i := 0
+	for i < len(times) && times[i].Before(windowStart) {
+		i++
+	}
+	return times[i:]
+}
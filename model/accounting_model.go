@@ -13,11 +13,13 @@ type SimpleOption struct {
 
 type ConfirmRequest struct {
 	Password string `json:"password"`
+	TotpCode string `json:"totpCode" validate:"omitempty,len=6,numeric"`
 	UserId   int64  `json:"-"`
 }
 
 type Bank struct {
 	Id        int64     `json:"id"`
+	TenantId  int64     `json:"tenantId"`
 	Name      string    `json:"name"`
 	Code      string    `json:"code"`
 	IconUrl   string    `json:"iconUrl"`
@@ -67,6 +69,7 @@ type AccountTypeListResponse struct {
 
 type BankAccount struct {
 	Id                      int64          `json:"id"`
+	TenantId                int64          `json:"tenantId"`
 	BankId                  int64          `json:"bankId"`
 	BankCode                string         `json:"bankCode"`
 	BankName                string         `json:"bankName"`
@@ -202,6 +205,7 @@ type BankAccountResponse struct {
 
 type BankAccountTransaction struct {
 	Id                int64          `json:"id"`
+	TenantId          int64          `json:"tenantId"`
 	AccountId         int64          `json:"accountId"`
 	Description       string         `json:"description"`
 	TransferType      string         `json:"transferType"`
@@ -252,6 +256,7 @@ type BankAccountTransactionResponse struct {
 
 type BankAccountTransfer struct {
 	Id                int64          `json:"id"`
+	TenantId          int64          `json:"tenantId"`
 	FromAccountId     int64          `json:"fromAccountId"`
 	FromBankId        int64          `json:"fromBankId"`
 	FromBankName      string         `json:"fromBankName"`
@@ -469,6 +474,7 @@ type ExternalListWithPagination struct {
 
 type ExternalAccountLog struct {
 	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
 	ExternalId         int64          `json:"externalId"`
 	ClientName         string         `json:"clientName"`
 	LogType            string         `json:"logType"`
@@ -497,6 +503,7 @@ type ExternalStatementListRequest struct {
 
 type ExternalAccountStatement struct {
 	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
 	ExternalId         int64          `json:"externalId"`
 	BankAccountId      int64          `json:"bankAccountId"`
 	BankCode           string         `json:"bankCode"`
@@ -559,6 +566,7 @@ type ExternalAccountError struct {
 
 type WebhookLog struct {
 	Id          int64          `json:"id"`
+	TenantId    int64          `json:"tenantId"`
 	JsonRequest string         `json:"jsonRequest"`
 	JsonPayload string         `json:"jsonPayload"`
 	LogType     string         `json:"logType"`
@@ -602,6 +610,7 @@ type WebhookStatement struct {
 
 type BotAccountConfig struct {
 	Id        int64  `json:"id"`
+	TenantId  int64  `json:"tenantId"`
 	ConfigKey string `json:"configKey"`
 	ConfigVal string `json:"configVal"`
 }
@@ -622,6 +631,7 @@ type BotAccountConfigCreateBody struct {
 
 type BankAccountPriority struct {
 	Id              int64      `json:"id"`
+	TenantId        int64      `json:"tenantId"`
 	Name            string     `json:"name"`
 	ConditionType   string     `json:"conditionType"`
 	MinDepositCount int        `json:"minDepositCount"`
@@ -7,42 +7,51 @@ import (
 )
 
 type Admin struct {
-	Id           int64          `json:"id"`
-	Username     string         `json:"username"`
-	Password     string         `json:"password"`
-	Fullname     string         `json:"fullname"`
-	Firstname    string         `json:"firstname"`
-	Lastname     string         `json:"lastname"`
-	Phone        string         `json:"phone"`
-	Email        string         `json:"email"`
-	Role         string         `json:"role"`
-	Status       string         `json:"status"`
-	AdminGroupId int64          `json:"adminGroupId"`
-	CreatedAt    time.Time      `json:"createdAt"`
-	UpdatedAt    time.Time      `json:"updatedAt"`
-	DeletedAt    gorm.DeletedAt `json:"deletedAt"`
-	LogedinAt    *time.Time     `json:"logedinAt" gorm:"default:CURRENT_TIMESTAMP"`
+	Id                int64          `json:"id"`
+	Username          string         `json:"username"`
+	Password          string         `json:"-"`
+	PasswordUpdatedAt *time.Time     `json:"passwordUpdatedAt"`
+	Fullname          string         `json:"fullname"`
+	Firstname         string         `json:"firstname"`
+	Lastname          string         `json:"lastname"`
+	Phone             string         `json:"phone"`
+	Email             string         `json:"email"`
+	Role              string         `json:"role"`
+	Status            string         `json:"status"`
+	AdminGroupId      int64          `json:"adminGroupId"`
+	PermissionVersion int64          `json:"permissionVersion"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	UpdatedAt         time.Time      `json:"updatedAt"`
+	DeletedAt         gorm.DeletedAt `json:"deletedAt"`
+	LogedinAt         *time.Time     `json:"logedinAt" gorm:"default:CURRENT_TIMESTAMP"`
 }
 
 type CreateAdmin struct {
-	Username     string           `json:"username" validate:"required,min=8,max=30"`
-	Password     string           `json:"password" validate:"required,min=8,max=30"`
-	Fullname     string           `json:"fullname" validate:"required,min=5,max=30"`
-	Phone        string           `json:"phone" validate:"required,min=10,max=12"`
-	Email        string           `json:"email" validate:"required,email"`
-	Status       string           `json:"status" validate:"required" default:"ACTIVE"`
-	AdminGroupId int64            `json:"adminGroupId" validate:"required"`
-	Permissions  *[]PermissionObj `json:"permissions" validate:"required"`
+	Username     string                     `json:"username" validate:"required,min=8,max=30"`
+	Password     string                     `json:"password" validate:"required,max=128"`
+	Fullname     string                     `json:"fullname" validate:"required,min=5,max=30"`
+	Phone        string                     `json:"phone" validate:"required,min=10,max=12"`
+	Email        string                     `json:"email" validate:"required,email"`
+	Status       string                     `json:"status" validate:"required" default:"ACTIVE"`
+	AdminGroupId int64                      `json:"adminGroupId" validate:"required"`
+	Permissions  *[]PermissionObj           `json:"permissions" validate:"required"`
+	Overrides    *[]AdminPermissionOverride `json:"overrides" validate:"omitempty,dive"`
 }
 
 type LoginAdmin struct {
-	Username string `json:"username" validate:"required,min=8,max=30"`
-	Password string `json:"password" validate:"required,min=8,max=30"`
-	Ip       string `json:"ip"`
+	Username     string `json:"username" validate:"required,min=8,max=30"`
+	Password     string `json:"password" validate:"required,max=128"`
+	TotpCode     string `json:"totpCode" validate:"omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recoveryCode"`
+	Ip           string `json:"ip"`
 }
 
 type LoginResponse struct {
-	Token string `json:"token"`
+	Token              string     `json:"token"`
+	RefreshToken       string     `json:"refreshToken,omitempty"`
+	ExpiredAt          *time.Time `json:"expiredAt,omitempty"`
+	TotpRequired       bool       `json:"totpRequired"`
+	TotpEnrollRequired bool       `json:"totpEnrollRequired"`
 }
 
 type AdminLoginUpdate struct {
@@ -64,6 +73,7 @@ type AdminPermissionList struct {
 
 type AdminGroupPermission struct {
 	Id           int64      `json:"id"`
+	TenantId     int64      `json:"tenantId"`
 	GroupId      int64      `json:"groupId"`
 	PermissionId int64      `json:"permissionId"`
 	IsRead       bool       `json:"isRead"`
@@ -82,6 +92,7 @@ type AdminGroupPermissionResponse struct {
 type AdminUpdateGroup struct {
 	// GroupId     int64           `json:"groupId" validate:"required"`
 	Name        string          `json:"name" validate:"required"`
+	RequireTotp *bool           `json:"requireTotp"`
 	Permissions []PermissionObj `json:"permissions" validate:"required"`
 }
 
@@ -116,18 +127,20 @@ type UpdateAdmin struct {
 }
 
 type AdminBody struct {
-	Fullname    string           `json:"fullname" validate:"required,min=5,max=30"`
-	Email       string           `json:"email" validate:"required,email"`
-	GroupId     *int64           `json:"groupId"`
-	Status      string           `json:"status" validate:"required" default:"ACTIVE"`
-	Permissions *[]PermissionObj `json:"permissions"`
+	Fullname    string                     `json:"fullname" validate:"required,min=5,max=30"`
+	Email       string                     `json:"email" validate:"required,email"`
+	GroupId     *int64                     `json:"groupId"`
+	Status      string                     `json:"status" validate:"required" default:"ACTIVE"`
+	Permissions *[]PermissionObj           `json:"permissions"`
+	Overrides   *[]AdminPermissionOverride `json:"overrides" validate:"omitempty,dive"`
 }
 
+// AdminPermission overrides the group grant for one admin. A nil flag inherits the group value, false denies it.
 type AdminPermission struct {
 	AdminId      int64 `json:"adminId"`
 	PermissionId int64 `json:"permissionId"`
-	IsRead       bool  `json:"read"`
-	IsWrite      bool  `json:"write"`
+	IsRead       *bool `json:"read"`
+	IsWrite      *bool `json:"write"`
 }
 
 type AdminList struct {
@@ -157,5 +170,6 @@ type AdminGroupId struct {
 }
 
 type AdminUpdatePassword struct {
-	Password string `json:"password" validate:"required,min=8,max=30"`
+	Password    string `json:"password" validate:"required,max=128"`
+	OldPassword string `json:"oldPassword"`
 }
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ApprovalThreshold struct {
+	Id              int64          `json:"id"`
+	TenantId        int64          `json:"tenantId"`
+	ActionType      string         `json:"actionType"`
+	MinAmount       float32        `json:"minAmount" sql:"type:decimal(14,2);"`
+	ApproverGroupId int64          `json:"approverGroupId"`
+	ExpireMinutes   int            `json:"expireMinutes"`
+	Status          string         `json:"status"`
+	CreatedAt       time.Time      `json:"createdAt"`
+	UpdatedAt       *time.Time     `json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt `json:"deletedAt"`
+}
+
+type ApprovalThresholdCreateBody struct {
+	ActionType      string  `json:"actionType" validate:"required" enums:"WITHDRAW_CONFIRM,BONUS_CREDIT,ACCOUNT_TRANSFER" example:"WITHDRAW_CONFIRM"`
+	MinAmount       float32 `json:"minAmount" validate:"required,gt=0"`
+	ApproverGroupId int64   `json:"approverGroupId" validate:"required"`
+	ExpireMinutes   int     `json:"expireMinutes" validate:"required,min=1" example:"60"`
+	Status          string  `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+}
+
+type ApprovalThresholdUpdateBody struct {
+	MinAmount       *float32 `json:"minAmount" validate:"omitempty,gt=0"`
+	ApproverGroupId *int64   `json:"approverGroupId"`
+	ExpireMinutes   *int     `json:"expireMinutes" validate:"omitempty,min=1"`
+	Status          *string  `json:"status" enums:"ACTIVE,DEACTIVE"`
+}
+
+type ApprovalRequest struct {
+	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
+	ActionType         string         `json:"actionType"`
+	TargetId           int64          `json:"targetId"`
+	Amount             float32        `json:"amount" sql:"type:decimal(14,2);"`
+	JsonPayload        string         `json:"jsonPayload"`
+	ApproverGroupId    int64          `json:"approverGroupId"`
+	Status             string         `json:"status"`
+	ExpiredAt          time.Time      `json:"expiredAt"`
+	CreatedByUserId    int64          `json:"createdByUserId"`
+	CreatedByUsername  string         `json:"createdByUsername"`
+	ApprovedAt         *time.Time     `json:"approvedAt"`
+	ApprovedByUserId   int64          `json:"approvedByUserId"`
+	ApprovedByUsername string         `json:"approvedByUsername"`
+	RejectRemark       string         `json:"rejectRemark"`
+	RejectedAt         *time.Time     `json:"rejectedAt"`
+	RejectedByUserId   int64          `json:"rejectedByUserId"`
+	RejectedByUsername string         `json:"rejectedByUsername"`
+	CreatedAt          time.Time      `json:"createdAt"`
+	UpdatedAt          *time.Time     `json:"updatedAt"`
+	DeletedAt          gorm.DeletedAt `json:"deletedAt"`
+}
+
+type ApprovalRequestCreateBody struct {
+	ActionType        string  `json:"-"`
+	TargetId          int64   `json:"-"`
+	Amount            float32 `json:"-"`
+	JsonPayload       string  `json:"-"`
+	CreatedByUserId   int64   `json:"-"`
+	CreatedByUsername string  `json:"-"`
+}
+
+type ApprovalRequestListRequest struct {
+	ActionType      string `form:"actionType" extensions:"x-order:1"`
+	Status          string `form:"status" extensions:"x-order:2"`
+	FromCreatedDate string `form:"fromCreatedDate" extensions:"x-order:3"`
+	ToCreatedDate   string `form:"toCreatedDate" extensions:"x-order:4"`
+	Search          string `form:"search" extensions:"x-order:5"`
+	Page            int    `form:"page" extensions:"x-order:6" default:"1" min:"1"`
+	Limit           int    `form:"limit" extensions:"x-order:7" default:"10" min:"1" max:"100"`
+	SortCol         string `form:"sortCol" extensions:"x-order:8"`
+	SortAsc         string `form:"sortAsc" extensions:"x-order:9"`
+}
+
+type ApprovalRequestResponse struct {
+	Id                 int64      `json:"id"`
+	ActionType         string     `json:"actionType"`
+	TargetId           int64      `json:"targetId"`
+	Amount             float32    `json:"amount"`
+	JsonPayload        string     `json:"jsonPayload"`
+	ApproverGroupId    int64      `json:"approverGroupId"`
+	ApproverGroupName  string     `json:"approverGroupName"`
+	Status             string     `json:"status"`
+	ExpiredAt          time.Time  `json:"expiredAt"`
+	CreatedByUsername  string     `json:"createdByUsername"`
+	ApprovedAt         *time.Time `json:"approvedAt"`
+	ApprovedByUsername string     `json:"approvedByUsername"`
+	RejectRemark       string     `json:"rejectRemark"`
+	RejectedAt         *time.Time `json:"rejectedAt"`
+	RejectedByUsername string     `json:"rejectedByUsername"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+type ApprovalApproveBody struct {
+	ConfirmRequest
+	ApprovedAt         time.Time `json:"-"`
+	ApprovedByUserId   int64     `json:"-"`
+	ApprovedByUsername string    `json:"-"`
+}
+
+type ApprovalRejectBody struct {
+	RejectRemark       string    `json:"rejectRemark" validate:"required,max=255"`
+	RejectedAt         time.Time `json:"-"`
+	RejectedByUserId   int64     `json:"-"`
+	RejectedByUsername string    `json:"-"`
+}
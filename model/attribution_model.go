@@ -0,0 +1,62 @@
+package model
+
+type AttributionReportRequest struct {
+	FromDate    string `form:"fromDate" validate:"required" extensions:"x-order:1" example:"2023-05-01"`
+	ToDate      string `form:"toDate" validate:"required" extensions:"x-order:2" example:"2023-05-31"`
+	GroupBy     string `form:"groupBy" extensions:"x-order:3" enums:"channel,partner,recommend" default:"channel"`
+	Channel     string `form:"channel" extensions:"x-order:4"`
+	Partner     string `form:"partner" extensions:"x-order:5"`
+	RecommendId string `form:"recommendId" extensions:"x-order:6"`
+}
+
+type AttributionReportRow struct {
+	Channel           string  `json:"channel"`
+	Partner           string  `json:"partner"`
+	RecommendId       int64   `json:"recommendId"`
+	RecommendTitle    string  `json:"recommendTitle"`
+	RegisterCount     int64   `json:"registerCount"`
+	FirstDepositCount int64   `json:"firstDepositCount"`
+	ConversionRate    float32 `json:"conversionRate"`
+	DepositCount      int64   `json:"depositCount"`
+	DepositAmount     float32 `json:"depositAmount"`
+	WithdrawAmount    float32 `json:"withdrawAmount"`
+	BonusAmount       float32 `json:"bonusAmount"`
+	NetRevenue        float32 `json:"netRevenue"`
+}
+
+type AttributionReportResponse struct {
+	FromDate string                 `json:"fromDate"`
+	ToDate   string                 `json:"toDate"`
+	GroupBy  string                 `json:"groupBy"`
+	Total    AttributionReportRow   `json:"total"`
+	List     []AttributionReportRow `json:"list"`
+}
+
+type CohortRetentionRequest struct {
+	FromDate    string `form:"fromDate" validate:"required" extensions:"x-order:1" example:"2023-01-01"`
+	ToDate      string `form:"toDate" validate:"required" extensions:"x-order:2" example:"2023-05-31"`
+	Period      string `form:"period" extensions:"x-order:3" enums:"day,week,month" default:"month"`
+	ActiveBy    string `form:"activeBy" extensions:"x-order:4" enums:"deposit,login" default:"deposit"`
+	Channel     string `form:"channel" extensions:"x-order:5"`
+	Partner     string `form:"partner" extensions:"x-order:6"`
+	RecommendId string `form:"recommendId" extensions:"x-order:7"`
+}
+
+type CohortRetentionCell struct {
+	Period        int     `json:"period"`
+	ActiveCount   int64   `json:"activeCount"`
+	RetentionRate float32 `json:"retentionRate"`
+	DepositAmount float32 `json:"depositAmount"`
+}
+
+type CohortRetentionRow struct {
+	Cohort        string                `json:"cohort"`
+	Channel       string                `json:"channel"`
+	RegisterCount int64                 `json:"registerCount"`
+	Cells         []CohortRetentionCell `json:"cells"`
+}
+
+type CohortRetentionResponse struct {
+	Period string               `json:"period"`
+	List   []CohortRetentionRow `json:"list"`
+}
@@ -0,0 +1,169 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+type AuditLog struct {
+	Id            int64     `json:"id"`
+	TenantId      int64     `json:"tenantId"`
+	RequestId     string    `json:"requestId"`
+	ActorType     string    `json:"actorType"`
+	ActorId       int64     `json:"actorId"`
+	ActorUsername string    `json:"actorUsername"`
+	Ip            string    `json:"ip"`
+	Action        string    `json:"action"`
+	TargetType    string    `json:"targetType"`
+	TargetId      int64     `json:"targetId"`
+	JsonBefore    string    `json:"jsonBefore"`
+	JsonAfter     string    `json:"jsonAfter"`
+	JsonChanges   string    `json:"jsonChanges"`
+	Description   string    `json:"description"`
+	DescriptionEn string    `json:"descriptionEn"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type AuditLogCreateBody struct {
+	RequestId     string `json:"requestId"`
+	ActorType     string `json:"actorType" validate:"required" enums:"ADMIN,USER,SYSTEM"`
+	ActorId       int64  `json:"actorId"`
+	ActorUsername string `json:"actorUsername"`
+	Ip            string `json:"ip"`
+	Action        string `json:"action" validate:"required" enums:"CREATE,UPDATE,DELETE,CONFIRM,CANCEL,LOGIN,LOGOUT"`
+	TargetType    string `json:"targetType" validate:"required" example:"admin"`
+	TargetId      int64  `json:"targetId"`
+	JsonBefore    string `json:"jsonBefore"`
+	JsonAfter     string `json:"jsonAfter"`
+	JsonChanges   string `json:"jsonChanges"`
+	Description   string `json:"description"`
+	DescriptionEn string `json:"descriptionEn"`
+}
+
+type AuditLogListRequest struct {
+	ActorType     string `form:"actorType" extensions:"x-order:1"`
+	ActorId       string `form:"actorId" extensions:"x-order:2"`
+	ActorUsername string `form:"actorUsername" extensions:"x-order:3"`
+	Action        string `form:"action" extensions:"x-order:4"`
+	TargetType    string `form:"targetType" extensions:"x-order:5"`
+	TargetId      string `form:"targetId" extensions:"x-order:6"`
+	RequestId     string `form:"requestId" extensions:"x-order:7"`
+	Ip            string `form:"ip" extensions:"x-order:8"`
+	FromDate      string `form:"fromDate" extensions:"x-order:9"`
+	ToDate        string `form:"toDate" extensions:"x-order:10"`
+	Search        string `form:"search" extensions:"x-order:11"`
+	Page          int    `form:"page" extensions:"x-order:12" default:"1" min:"1"`
+	Limit         int    `form:"limit" extensions:"x-order:13" default:"10" min:"1" max:"100"`
+	SortCol       string `form:"sortCol" extensions:"x-order:14"`
+	SortAsc       string `form:"sortAsc" extensions:"x-order:15"`
+}
+
+type AuditLogResponse struct {
+	Id            int64          `json:"id"`
+	RequestId     string         `json:"requestId"`
+	ActorType     string         `json:"actorType"`
+	ActorId       int64          `json:"actorId"`
+	ActorUsername string         `json:"actorUsername"`
+	Ip            string         `json:"ip"`
+	Action        string         `json:"action"`
+	TargetType    string         `json:"targetType"`
+	TargetId      int64          `json:"targetId"`
+	Description   string         `json:"description"`
+	DescriptionEn string         `json:"descriptionEn"`
+	JsonChanges   string         `json:"-"`
+	Changes       *[]FieldChange `json:"changes" gorm:"-"`
+	CreatedAt     time.Time      `json:"createdAt"`
+}
+
+// AuditActor is filled once per request by the handler and passed to the NewAudit helpers.
+type AuditActor struct {
+	RequestId     string
+	ActorType     string
+	ActorId       int64
+	ActorUsername string
+	Ip            string
+}
+
+func NewAuditCreate(actor AuditActor, targetType string, targetId int64, after interface{}) AuditLogCreateBody {
+	return newAuditLog(actor, "CREATE", targetType, targetId, nil, after)
+}
+
+// NewAuditUpdate records the snapshots and field diff of an edit. after is usually the update body, so only the fields it sets show up in the diff.
+func NewAuditUpdate(actor AuditActor, targetType string, targetId int64, before, after interface{}, opts ...DiffOption) AuditLogCreateBody {
+	return newAuditLog(actor, "UPDATE", targetType, targetId, before, after, opts...)
+}
+
+func NewAuditDelete(actor AuditActor, targetType string, targetId int64, before interface{}) AuditLogCreateBody {
+	body := newAuditLog(actor, "DELETE", targetType, targetId, before, nil)
+	body.Description = "ลบข้อมูล"
+	body.DescriptionEn = "Deleted"
+	return body
+}
+
+// NewAuditAction records actions that are not a plain edit, such as CONFIRM, CANCEL, LOGIN and LOGOUT.
+func NewAuditAction(actor AuditActor, action string, targetType string, targetId int64, payload interface{}) AuditLogCreateBody {
+	body := newAuditLog(actor, action, targetType, targetId, nil, nil)
+	body.JsonAfter = redactedJson(payload)
+	return body
+}
+
+func newAuditLog(actor AuditActor, action string, targetType string, targetId int64, before, after interface{}, opts ...DiffOption) AuditLogCreateBody {
+	body := AuditLogCreateBody{
+		RequestId:     actor.RequestId,
+		ActorType:     actor.ActorType,
+		ActorId:       actor.ActorId,
+		ActorUsername: actor.ActorUsername,
+		Ip:            actor.Ip,
+		Action:        action,
+		TargetType:    targetType,
+		TargetId:      targetId,
+		JsonBefore:    redactedJson(before),
+		JsonAfter:     redactedJson(after),
+	}
+	if after != nil {
+		set := NewChangeSet(targetType, targetId, before, after, opts...)
+		body.JsonChanges = set.JsonChanges()
+		body.Description = set.DescriptionTh
+		body.DescriptionEn = set.DescriptionEn
+	}
+	return body
+}
+
+// redactedJson marshals a snapshot and masks every secret key at any depth.
+func redactedJson(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return string(b)
+	}
+	b, err = json.Marshal(redactJsonValue(data))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func redactJsonValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for key, value := range t {
+			if value != nil && secretFields[strings.ToLower(key)] {
+				t[key] = RedactedValue
+				continue
+			}
+			t[key] = redactJsonValue(value)
+		}
+	case []interface{}:
+		for i, value := range t {
+			t[i] = redactJsonValue(value)
+		}
+	}
+	return v
+}
@@ -8,6 +8,7 @@ import (
 
 type Member struct {
 	Id            int64     `json:"id"`
+	TenantId      int64     `json:"tenantId"`
 	MemberCode    string    `json:"memberCode"`
 	Username      string    `json:"username"`
 	Phone         string    `json:"phone"`
@@ -18,6 +19,7 @@ type Member struct {
 	Bankname      string    `json:"bankname"`
 	BankAccount   string    `json:"bankAccount"`
 	Promotion     string    `json:"promotion"`
+	Tier          string    `json:"tier"`
 	Status        string    `json:"status"`
 	Channel       string    `json:"channel"`
 	TrueWallet    string    `json:"trueWallet"`
@@ -46,6 +48,7 @@ type MemberPossibleListRequest struct {
 
 type BankStatement struct {
 	Id                int64          `json:"id" gorm:"primaryKey"`
+	TenantId          int64          `json:"tenantId"`
 	AccountId         int64          `json:"accountId"`
 	Amount            float32        `json:"amount" sql:"type:decimal(14,2);"`
 	Detail            string         `json:"detail"`
@@ -132,6 +135,7 @@ type BankStatementResponse struct {
 
 type BankTransaction struct {
 	Id                  int64          `json:"id" gorm:"primaryKey"`
+	TenantId            int64          `json:"tenantId"`
 	MemberCode          string         `json:"memberCode"`
 	UserId              int64          `json:"userId"`
 	TransferType        string         `json:"transferType"`
@@ -213,6 +217,7 @@ type BankTransactionCreateBody struct {
 	DepositChannel    string     `json:"depositChannel"`
 	OverAmount        float32    `json:"overAmount"`
 	BonusAmount       float32    `json:"bonusAmount"`
+	BonusReason       string     `json:"-"`
 	BeforeAmount      float32    `json:"-"`
 	AfterAmount       float32    `json:"-"`
 	TransferAt        *time.Time `json:"transferAt" example:"2023-05-31T22:33:44+07:00"`
@@ -389,6 +394,7 @@ type CreateBankTransactionActionBody struct {
 	FromAccountId       int64     `json:"fromAccountId"`
 	ToAccountId         int64     `json:"toAccountId"`
 	JsonBefore          string    `json:"jsonBefore"`
+	JsonChanges         string    `json:"jsonChanges"`
 	TransferAt          time.Time `json:"transferAt"`
 	SlipUrl             string    `json:"slipUrl"`
 	BonusAmount         float32   `json:"bonusAmount"`
@@ -405,6 +411,7 @@ type CreateBankStatementActionBody struct {
 	ActionType          string    `json:"actionType"`
 	AccountId           int64     `json:"accountId"`
 	JsonBefore          string    `json:"jsonBefore"`
+	JsonChanges         string    `json:"jsonChanges"`
 	ConfirmedAt         time.Time `json:"confirmedAt"`
 	ConfirmedByUserId   int64     `json:"confirmedByUserId"`
 	ConfirmedByUsername string    `json:"confirmedByUsername"`
@@ -437,6 +444,7 @@ type MemberTransactionSummary struct {
 
 type MemberTransaction struct {
 	Id                  int64          `json:"id" gorm:"primaryKey"`
+	TenantId            int64          `json:"tenantId"`
 	UserId              int64          `json:"userId"`
 	MemberCode          string         `json:"memberCode"`
 	UserUsername        string         `json:"userUsername"`
@@ -0,0 +1,328 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type FieldChange struct {
+	Field    string      `json:"field"`
+	LabelTh  string      `json:"labelTh"`
+	LabelEn  string      `json:"labelEn"`
+	OldValue interface{} `json:"oldValue"`
+	NewValue interface{} `json:"newValue"`
+	Redacted bool        `json:"redacted"`
+}
+
+type ChangeSet struct {
+	TargetType    string        `json:"targetType"`
+	TargetId      int64         `json:"targetId"`
+	Changes       []FieldChange `json:"changes"`
+	DescriptionTh string        `json:"descriptionTh"`
+	DescriptionEn string        `json:"descriptionEn"`
+}
+
+const RedactedValue = "******"
+
+// secretFields are compared but never written to a change log. A field can also opt in with `diff:"secret"`.
+var secretFields = map[string]bool{
+	"password":     true,
+	"oldpassword":  true,
+	"pincode":      true,
+	"pin":          true,
+	"token":        true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+	"secret":       true,
+	"clientsecret": true,
+	"totpsecret":   true,
+	"apikey":       true,
+	"recoverycode": true,
+}
+
+// skipFields are bookkeeping columns that change on every write.
+var skipFields = map[string]bool{
+	"Id":        true,
+	"CreatedAt": true,
+	"UpdatedAt": true,
+	"DeletedAt": true,
+}
+
+var FieldLabelsTh = map[string]string{
+	"fullname":         "ชื่อ-นามสกุล",
+	"firstname":        "ชื่อ",
+	"lastname":         "นามสกุล",
+	"username":         "ชื่อผู้ใช้",
+	"email":            "อีเมล",
+	"phone":            "เบอร์โทรศัพท์",
+	"password":         "รหัสผ่าน",
+	"status":           "สถานะ",
+	"groupId":          "กลุ่มผู้ดูแล",
+	"adminGroupId":     "กลุ่มผู้ดูแล",
+	"partner":          "พันธมิตร",
+	"memberCode":       "รหัสสมาชิก",
+	"promotion":        "โปรโมชั่น",
+	"tier":             "ระดับสมาชิก",
+	"bankname":         "ชื่อธนาคาร",
+	"bankCode":         "รหัสธนาคาร",
+	"bankId":           "ธนาคาร",
+	"bankAccount":      "เลขบัญชีธนาคาร",
+	"channel":          "ช่องทางที่รู้จัก",
+	"trueWallet":       "ทรูวอลเล็ท",
+	"contact":          "ช่องทางติดต่อ",
+	"note":             "หมายเหตุ",
+	"course":           "คอร์ส",
+	"ip":               "ไอพี",
+	"accountName":      "ชื่อบัญชี",
+	"accountNumber":    "เลขบัญชี",
+	"accounTypeId":     "ประเภทบัญชี",
+	"deviceUid":        "รหัสอุปกรณ์",
+	"pinCode":          "รหัส PIN",
+	"autoCreditFlag":   "เติมเครดิตอัตโนมัติ",
+	"isMainWithdraw":   "บัญชีถอนหลัก",
+	"autoWithdrawFlag": "ถอนอัตโนมัติ",
+	"accountStatus":    "สถานะบัญชี",
+	"qrWalletStatus":   "สถานะ QR Wallet",
+	"logo":             "โลโก้",
+	"backgrondcolor":   "สีพื้นหลัง",
+	"userAuto":         "สร้างยูสเซอร์อัตโนมัติ",
+	"otpRegister":      "OTP ตอนสมัคร",
+	"autowithdraw":     "ถอนอัตโนมัติ",
+	"tranWithdraw":     "โอนถอน",
+	"register":         "การสมัครสมาชิก",
+	"depositFirst":     "ฝากครั้งแรก",
+	"depositNext":      "ฝากครั้งถัดไป",
+	"withdraw":         "การถอน",
+	"line":             "ไลน์",
+	"url":              "ลิงก์",
+}
+
+type DiffOption int
+
+const (
+	// DiffIgnoreZero treats zero values in after as "not sent", for update bodies with plain fields such as
+	// UpdateUser and SettingwebUpdateBody. A field can then not be cleared through the diff.
+	DiffIgnoreZero DiffOption = iota + 1
+)
+
+// DiffFields compares the fields that before and after share by name and returns the ones that differ.
+// after is usually an update body: nil pointer fields mean "not sent" and are skipped, as are zero fields with
+// DiffIgnoreZero. Slices, maps and structs are compared and recorded as JSON. before may be nil for a create.
+func DiffFields(before, after interface{}, opts ...DiffOption) []FieldChange {
+	ignoreZero := false
+	for _, opt := range opts {
+		ignoreZero = ignoreZero || opt == DiffIgnoreZero
+	}
+	av := indirectValue(reflect.ValueOf(after))
+	if !av.IsValid() || av.Kind() != reflect.Struct {
+		return nil
+	}
+	bv := indirectValue(reflect.ValueOf(before))
+	if bv.IsValid() && bv.Kind() != reflect.Struct {
+		bv = reflect.Value{}
+	}
+
+	var changes []FieldChange
+	at := av.Type()
+	for i := 0; i < at.NumField(); i++ {
+		sf := at.Field(i)
+		tag := sf.Tag.Get("diff")
+		if !sf.IsExported() || sf.Anonymous || skipFields[sf.Name] || tag == "-" {
+			continue
+		}
+		newField := av.Field(i)
+		if newField.Kind() == reflect.Ptr && newField.IsNil() {
+			continue
+		}
+		newValue, ok := fieldValue(newField)
+		if !ok || (ignoreZero && isZero(newValue)) {
+			continue
+		}
+		var oldValue interface{}
+		if bv.IsValid() {
+			if oldField := bv.FieldByName(sf.Name); oldField.IsValid() {
+				oldValue, _ = fieldValue(oldField)
+			}
+		}
+		if sameValue(oldValue, newValue) {
+			continue
+		}
+		change := FieldChange{
+			Field:    fieldKey(sf),
+			LabelEn:  labelEn(sf.Name),
+			OldValue: oldValue,
+			NewValue: newValue,
+		}
+		change.LabelTh = FieldLabelsTh[change.Field]
+		if change.LabelTh == "" {
+			change.LabelTh = change.LabelEn
+		}
+		if tag == "secret" || secretFields[strings.ToLower(sf.Name)] {
+			change.OldValue = RedactedValue
+			change.NewValue = RedactedValue
+			change.Redacted = true
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// DescribeChanges renders the Thai and English descriptions stored on a change log row.
+func DescribeChanges(changes []FieldChange) (string, string) {
+	th := make([]string, 0, len(changes))
+	en := make([]string, 0, len(changes))
+	for _, c := range changes {
+		if c.Redacted {
+			th = append(th, fmt.Sprintf("แก้ไข%s", c.LabelTh))
+			en = append(en, fmt.Sprintf("Changed %s", c.LabelEn))
+			continue
+		}
+		th = append(th, fmt.Sprintf("แก้ไข%s จาก %s เป็น %s", c.LabelTh, displayValue(c.OldValue), displayValue(c.NewValue)))
+		en = append(en, fmt.Sprintf("Changed %s from %s to %s", c.LabelEn, displayValue(c.OldValue), displayValue(c.NewValue)))
+	}
+	return strings.Join(th, ", "), strings.Join(en, ", ")
+}
+
+func NewChangeSet(targetType string, targetId int64, before, after interface{}, opts ...DiffOption) ChangeSet {
+	set := ChangeSet{
+		TargetType: targetType,
+		TargetId:   targetId,
+		Changes:    DiffFields(before, after, opts...),
+	}
+	set.DescriptionTh, set.DescriptionEn = DescribeChanges(set.Changes)
+	return set
+}
+
+func (c ChangeSet) HasChanges() bool {
+	return len(c.Changes) > 0
+}
+
+// JsonChanges is the value persisted in the JsonChanges columns.
+func (c ChangeSet) JsonChanges() string {
+	if len(c.Changes) == 0 {
+		return "[]"
+	}
+	b, err := json.Marshal(c.Changes)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
+
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func scalarValue(v reflect.Value) (interface{}, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, true
+		}
+		v = v.Elem()
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t, true
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return v.Interface(), true
+	}
+	return nil, false
+}
+
+// fieldValue returns scalars as they are and slices, maps and structs as their JSON encoding.
+func fieldValue(v reflect.Value) (interface{}, bool) {
+	if value, ok := scalarValue(v); ok {
+		return value, true
+	}
+	switch reflect.Indirect(v).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, false
+		}
+		return json.RawMessage(b), true
+	}
+	return nil, false
+}
+
+func sameValue(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return isZero(a) && isZero(b)
+	}
+	if ar, ok := a.(json.RawMessage); ok {
+		br, ok := b.(json.RawMessage)
+		return ok && string(ar) == string(br) || isZero(a) && isZero(b)
+	}
+	if _, ok := b.(json.RawMessage); ok {
+		return false
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Equal(bt)
+		}
+	}
+	if reflect.TypeOf(a) == reflect.TypeOf(b) {
+		return a == b
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+func isZero(v interface{}) bool {
+	if raw, ok := v.(json.RawMessage); ok {
+		switch string(raw) {
+		case "", "null", "[]", "{}":
+			return true
+		}
+		return false
+	}
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+func displayValue(v interface{}) string {
+	if isZero(v) {
+		return "-"
+	}
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	if raw, ok := v.(json.RawMessage); ok {
+		return string(raw)
+	}
+	return fmt.Sprintf("%q", fmt.Sprint(v))
+}
+
+func fieldKey(sf reflect.StructField) string {
+	name := strings.Split(sf.Tag.Get("json"), ",")[0]
+	if name != "" && name != "-" {
+		return name
+	}
+	r := []rune(sf.Name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func labelEn(name string) string {
+	var b strings.Builder
+	r := []rune(name)
+	for i, c := range r {
+		if i > 0 && unicode.IsUpper(c) && (unicode.IsLower(r[i-1]) || (i+1 < len(r) && unicode.IsLower(r[i+1]))) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
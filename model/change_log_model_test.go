@@ -0,0 +1,100 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func stringPtr(v string) *string { return &v }
+
+func TestDiffFieldsPointerBody(t *testing.T) {
+	before := Tenant{Name: "Brand A", Domain: "a.example.com", Status: "ACTIVE"}
+	after := TenantUpdateBody{Status: stringPtr("DEACTIVE"), Domain: stringPtr("a.example.com")}
+
+	changes := DiffFields(before, after)
+	if len(changes) != 1 || changes[0].Field != "status" || changes[0].OldValue != "ACTIVE" || changes[0].NewValue != "DEACTIVE" {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if changes[0].LabelTh != "สถานะ" || changes[0].LabelEn != "Status" {
+		t.Fatalf("unexpected labels %+v", changes[0])
+	}
+}
+
+func TestDiffFieldsIgnoreZero(t *testing.T) {
+	before := User{Partner: stringPtr("P1")}
+	after := UpdateUser{Note: "vip2"}
+
+	if changes := DiffFields(before, after); len(changes) < 2 {
+		t.Fatalf("without the option every empty field counts as cleared, got %+v", changes)
+	}
+	changes := DiffFields(before, after, DiffIgnoreZero)
+	if len(changes) != 1 || changes[0].Field != "note" {
+		t.Fatalf("expected only the note to change, got %+v", changes)
+	}
+}
+
+func TestDiffFieldsSlices(t *testing.T) {
+	before := Group{Name: "Support"}
+	after := AdminUpdateGroup{Name: "Support", Permissions: []PermissionObj{{Id: 3, IsRead: true}}}
+
+	set := NewChangeSet("GROUP", 1, before, after)
+	if len(set.Changes) != 1 || set.Changes[0].Field != "permissions" {
+		t.Fatalf("expected the permissions to be recorded, got %+v", set.Changes)
+	}
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(set.JsonChanges()), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := decoded[0]["newValue"].([]interface{}); !ok {
+		t.Fatalf("expected the new value as JSON, got %s", set.JsonChanges())
+	}
+	if !strings.Contains(set.DescriptionEn, `[{"id":3,"read":true,"write":false}]`) {
+		t.Fatalf("unexpected description %q", set.DescriptionEn)
+	}
+
+	same := AdminUpdateGroup{Name: "Support", Permissions: []PermissionObj{{Id: 3, IsRead: true}}}
+	if changes := DiffFields(after, same); len(changes) != 0 {
+		t.Fatalf("equal slices must not be a change, got %+v", changes)
+	}
+}
+
+func TestDiffFieldsRedaction(t *testing.T) {
+	type body struct {
+		Password string
+		PinCode  *string `json:"pinCode"`
+		Remark   string  `diff:"secret"`
+		Internal string  `diff:"-"`
+	}
+	changes := DiffFields(body{Password: "old"}, body{Password: "new", PinCode: stringPtr("1234"), Remark: "x", Internal: "y"})
+	if len(changes) != 3 {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	for _, c := range changes {
+		if !c.Redacted || c.OldValue != RedactedValue || c.NewValue != RedactedValue {
+			t.Fatalf("expected %s to be redacted, got %+v", c.Field, c)
+		}
+	}
+	th, en := DescribeChanges(changes)
+	if strings.Contains(th+en, "old") || strings.Contains(th+en, "1234") || en != "Changed Password, Changed Pin Code, Changed Remark" {
+		t.Fatalf("unexpected descriptions %q %q", th, en)
+	}
+
+	audit := NewAuditUpdate(AuditActor{}, "ADMIN", 1, body{Password: "s3cret-before"}, body{Password: "s3cret-after"})
+	if strings.Contains(audit.JsonBefore+audit.JsonAfter+audit.JsonChanges, "s3cret") {
+		t.Fatalf("secrets leaked into the audit log: %+v", audit)
+	}
+}
+
+func TestDescribeChanges(t *testing.T) {
+	th, en := DescribeChanges([]FieldChange{
+		{LabelTh: "สถานะ", LabelEn: "Status", OldValue: "ACTIVE", NewValue: "DEACTIVE"},
+		{LabelTh: "หมายเหตุ", LabelEn: "Note", OldValue: nil, NewValue: "vip"},
+	})
+	if th != `แก้ไขสถานะ จาก "ACTIVE" เป็น "DEACTIVE", แก้ไขหมายเหตุ จาก - เป็น "vip"` {
+		t.Fatalf("unexpected th %q", th)
+	}
+	if en != `Changed Status from "ACTIVE" to "DEACTIVE", Changed Note from - to "vip"` {
+		t.Fatalf("unexpected en %q", en)
+	}
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// FeatureFlag is global: flags, rules and their history are shared by every tenant, and a rule reaches a
+// single tenant through TargetTenantId. When no rule matches, a tenant's BotAccountConfig row for
+// ConfigKey still applies, as it did before flags existed.
+type FeatureFlag struct {
+	Id                int64          `json:"id"`
+	FlagKey           string         `json:"flagKey"`
+	ConfigKey         string         `json:"configKey"`
+	ValueType         string         `json:"valueType"`
+	DefaultValue      string         `json:"defaultValue"`
+	Description       string         `json:"description"`
+	IsEnabled         bool           `json:"isEnabled"`
+	Version           int64          `json:"version"`
+	UpdatedByUsername string         `json:"updatedByUsername"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	UpdatedAt         *time.Time     `json:"updatedAt"`
+	DeletedAt         gorm.DeletedAt `json:"deletedAt"`
+}
+
+type FeatureFlagRule struct {
+	Id             int64      `json:"id"`
+	FeatureFlagId  int64      `json:"featureFlagId"`
+	Priority       int        `json:"priority"`
+	TargetTenantId *int64     `json:"targetTenantId"`
+	MemberTier     string     `json:"memberTier"`
+	Percentage     int        `json:"percentage"`
+	Value          string     `json:"value"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+type FeatureFlagRuleBody struct {
+	Priority       int    `json:"priority" validate:"min=0"`
+	TargetTenantId *int64 `json:"targetTenantId"`
+	MemberTier     string `json:"memberTier" validate:"max=20"`
+	Percentage     *int   `json:"percentage" validate:"omitempty,min=0,max=100" default:"100"`
+	Value          string `json:"value" validate:"required"`
+}
+
+// RolloutPercentage is the stored percentage; a rule sent without one applies to everyone.
+func (b FeatureFlagRuleBody) RolloutPercentage() int {
+	if b.Percentage == nil {
+		return 100
+	}
+	return *b.Percentage
+}
+
+type FeatureFlagCreateBody struct {
+	FlagKey           string                `json:"flagKey" validate:"required,max=100" example:"auto_withdraw"`
+	ConfigKey         string                `json:"configKey" validate:"max=100"`
+	ValueType         string                `json:"valueType" validate:"required" enums:"BOOL,INT,FLOAT,STRING" example:"BOOL"`
+	DefaultValue      string                `json:"defaultValue" validate:"required" example:"false"`
+	Description       string                `json:"description" validate:"max=255"`
+	IsEnabled         bool                  `json:"isEnabled" default:"true"`
+	Rules             []FeatureFlagRuleBody `json:"rules" validate:"dive"`
+	UpdatedByUsername string                `json:"-"`
+}
+
+type FeatureFlagUpdateBody struct {
+	DefaultValue      *string                `json:"defaultValue"`
+	Description       *string                `json:"description" validate:"omitempty,max=255"`
+	IsEnabled         *bool                  `json:"isEnabled"`
+	Rules             *[]FeatureFlagRuleBody `json:"rules" validate:"omitempty,dive"`
+	Version           int64                  `json:"version" validate:"required"`
+	UpdatedByUsername string                 `json:"-"`
+}
+
+type FeatureFlagListRequest struct {
+	ValueType string `form:"valueType"`
+	Page      int    `form:"page" default:"1" min:"1"`
+	Limit     int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search    string `form:"search"`
+	SortCol   string `form:"sortCol"`
+	SortAsc   string `form:"sortAsc"`
+}
+
+type FeatureFlagResponse struct {
+	Id                int64             `json:"id"`
+	FlagKey           string            `json:"flagKey"`
+	ConfigKey         string            `json:"configKey"`
+	ValueType         string            `json:"valueType"`
+	DefaultValue      string            `json:"defaultValue"`
+	Description       string            `json:"description"`
+	IsEnabled         bool              `json:"isEnabled"`
+	Version           int64             `json:"version"`
+	Rules             []FeatureFlagRule `json:"rules" gorm:"-"`
+	UpdatedByUsername string            `json:"updatedByUsername"`
+	UpdatedAt         *time.Time        `json:"updatedAt"`
+}
+
+type FeatureFlagChange struct {
+	Id                int64     `json:"id"`
+	FeatureFlagId     int64     `json:"featureFlagId"`
+	FlagKey           string    `json:"flagKey"`
+	Version           int64     `json:"version"`
+	JsonBefore        string    `json:"jsonBefore"`
+	JsonAfter         string    `json:"jsonAfter"`
+	CreatedByUsername string    `json:"createdByUsername"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type FeatureFlagEvaluateRequest struct {
+	FlagKey    string `form:"flagKey" json:"flagKey" validate:"required"`
+	TenantId   int64  `form:"tenantId" json:"tenantId"`
+	UserId     int64  `form:"userId" json:"userId"`
+	MemberTier string `form:"memberTier" json:"memberTier"`
+}
+
+type FeatureFlagEvaluateResponse struct {
+	FlagKey   string `json:"flagKey"`
+	ValueType string `json:"valueType"`
+	Value     string `json:"value"`
+	RuleId    *int64 `json:"ruleId"`
+	Source    string `json:"source" enums:"RULE,DEFAULT,DISABLED,CONFIG"`
+	Version   int64  `json:"version"`
+}
@@ -5,16 +5,21 @@ import (
 )
 
 type Group struct {
-	Id         int64      `json:"id"`
-	Name       string     `json:"name"`
-	AdminCount int64      `json:"adminCount"`
-	CreatedAt  time.Time  `json:"createdAt"`
-	UpdatedAt  time.Time  `json:"updatedAt"`
-	DeletedAt  *time.Time `json:"deletedAt"`
+	Id                 int64      `json:"id"`
+	TenantId           int64      `json:"tenantId"`
+	Name               string     `json:"name"`
+	AdminCount         int64      `json:"adminCount"`
+	PermissionVersion  int64      `json:"permissionVersion"`
+	RequireTotp        bool       `json:"requireTotp"`
+	IpAllowlistEnabled bool       `json:"ipAllowlistEnabled"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+	DeletedAt          *time.Time `json:"deletedAt"`
 }
 
 type CreateGroup struct {
 	Name        string          `json:"name" validate:"required"`
+	RequireTotp bool            `json:"requireTotp" default:"false"`
 	Permissions []PermissionObj `json:"permissions" validate:"required"`
 }
 
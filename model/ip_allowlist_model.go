@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AdminIpAllowlist struct {
+	Id                int64          `json:"id"`
+	TenantId          int64          `json:"tenantId"`
+	GroupId           *int64         `json:"groupId"`
+	AdminId           *int64         `json:"adminId"`
+	Cidr              string         `json:"cidr"`
+	Description       string         `json:"description"`
+	CreatedByUsername string         `json:"createdByUsername"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	UpdatedAt         *time.Time     `json:"updatedAt"`
+	DeletedAt         gorm.DeletedAt `json:"deletedAt"`
+}
+
+type AdminIpAllowlistCreateBody struct {
+	GroupId           *int64 `json:"groupId" validate:"required_without=AdminId,excluded_with=AdminId"`
+	AdminId           *int64 `json:"adminId" validate:"required_without=GroupId,excluded_with=GroupId"`
+	Cidr              string `json:"cidr" validate:"required,cidr|ip" example:"203.0.113.0/24"`
+	Description       string `json:"description" validate:"max=255"`
+	CreatedByUsername string `json:"-"`
+}
+
+type AdminIpAllowlistUpdateBody struct {
+	Cidr        *string `json:"cidr" validate:"omitempty,cidr|ip"`
+	Description *string `json:"description" validate:"omitempty,max=255"`
+}
+
+type AdminIpAllowlistListRequest struct {
+	GroupId string `form:"groupId"`
+	AdminId string `form:"adminId"`
+	Page    int    `form:"page" default:"1" min:"1"`
+	Limit   int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search  string `form:"search"`
+	SortCol string `form:"sortCol"`
+	SortAsc string `form:"sortAsc"`
+}
+
+type AdminIpAllowlistResponse struct {
+	Id                int64      `json:"id"`
+	GroupId           *int64     `json:"groupId"`
+	GroupName         string     `json:"groupName"`
+	AdminId           *int64     `json:"adminId"`
+	AdminUsername     string     `json:"adminUsername"`
+	Cidr              string     `json:"cidr"`
+	Description       string     `json:"description"`
+	CreatedByUsername string     `json:"createdByUsername"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+}
+
+type AdminIpBypass struct {
+	Id                int64      `json:"id"`
+	TenantId          int64      `json:"tenantId"`
+	AdminId           int64      `json:"adminId"`
+	Ip                string     `json:"ip"`
+	Reason            string     `json:"reason"`
+	ExpiredAt         time.Time  `json:"expiredAt"`
+	RevokedAt         *time.Time `json:"revokedAt"`
+	CreatedByUserId   int64      `json:"createdByUserId"`
+	CreatedByUsername string     `json:"createdByUsername"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
+
+type AdminIpBypassCreateBody struct {
+	AdminId           int64  `json:"adminId" validate:"required"`
+	Ip                string `json:"ip" validate:"required,ip" example:"1.1.1.1"`
+	Reason            string `json:"reason" validate:"required,max=255"`
+	Minutes           int    `json:"minutes" validate:"required,min=1,max=1440" example:"60"`
+	CreatedByUserId   int64  `json:"-"`
+	CreatedByUsername string `json:"-"`
+}
+
+type AdminIpBypassListRequest struct {
+	AdminId         string `form:"adminId"`
+	FromCreatedDate string `form:"fromCreatedDate"`
+	ToCreatedDate   string `form:"toCreatedDate"`
+	Page            int    `form:"page" default:"1" min:"1"`
+	Limit           int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search          string `form:"search"`
+	SortCol         string `form:"sortCol"`
+	SortAsc         string `form:"sortAsc"`
+}
+
+type AdminIpCheckResponse struct {
+	AdminId   int64  `json:"adminId"`
+	Ip        string `json:"ip"`
+	Allowed   bool   `json:"allowed"`
+	MatchedBy string `json:"matchedBy" enums:"GROUP,ADMIN,BYPASS,DISABLED"`
+	RuleId    *int64 `json:"ruleId"`
+}
@@ -6,6 +6,7 @@ import (
 
 type Linenotify struct {
 	Id          int64      `json:"id"`
+	TenantId    int64      `json:"tenantId"`
 	StartCredit float32    `json:"startcredit" sql:"type:decimal(14,2);"`
 	Token       string     `json:"token" validate:"required"`
 	NotifyId    int64      `json:"notifyId" validate:"required"`
@@ -59,13 +60,14 @@ type LinenotifyUpdateRequest struct {
 
 type LinenotifyGame struct {
 	Id           int64      `json:"id"`
+	TenantId     int64      `json:"tenantId"`
 	Name         string     `json:"name" validate:"required"`
 	ClientId     string     `json:"clientid" validate:"required"`
 	ClientSecret string     `json:"clientsecret" validate:"required"`
 	ResponseType string     `json:"responsetype" validate:"required"`
 	RedirectUri  string     `json:"redirecturi" validate:"required"`
 	Scope        string     `json:"scope" validate:"required"`
-	State        string     `json:"state" validate:"required"`
+	State        string     `json:"state"`
 	CreatedAt    time.Time  `json:"createdAt"`
 	UpdatedAt    *time.Time `json:"updatedAt"`
 }
@@ -78,7 +80,7 @@ type LinenotifyGameResponse struct {
 	Responsetype string `json:"responsetype" validate:"required"`
 	Redirecturi  string `json:"redirecturi" validate:"required"`
 	Scope        string `json:"scope" validate:"required"`
-	State        string `json:"state" validate:"required"`
+	State        string `json:"state"`
 }
 
 type LinenotifyGameParam struct {
@@ -89,11 +91,15 @@ type LinenotifyGameParam struct {
 }
 
 type LineNoifyUsergame struct {
-	UserId       int64      `json:"name" validate:"required"`
-	TypeNotifyId string     `json:"TypeNotifyId" validate:"required"`
-	Token        string     `json:"token" validate:"required"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	UpdatedAt    *time.Time `json:"updatedAt"`
+	Id              int64      `json:"id"`
+	TenantId        int64      `json:"tenantId"`
+	UserId          int64      `json:"name" validate:"required"`
+	TypeNotifyId    string     `json:"TypeNotifyId" validate:"required"`
+	Token           string     `json:"token" validate:"required"`
+	Status          string     `json:"status"`
+	StatusCheckedAt *time.Time `json:"statusCheckedAt"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       *time.Time `json:"updatedAt"`
 }
 
 type LineNoifyUsergameBody struct {
@@ -115,3 +121,103 @@ type LineNotifyRedirectReponse struct {
 	Code  string `json:"code"`
 	State string `json:"state"`
 }
+
+// LinenotifyType is a platform lookup table of the events LINE notify can report, seeded once per
+// deployment and shared by every tenant. Which events a tenant sends lives in the scoped Linenotify rows.
+type LinenotifyType struct {
+	Id        int64      `json:"id"`
+	EventKey  string     `json:"eventKey"`
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type LinenotifyTemplate struct {
+	Id        int64      `json:"id"`
+	TenantId  int64      `json:"tenantId"`
+	NotifyId  int64      `json:"notifyId"`
+	Template  string     `json:"template"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type LinenotifyTemplateResponse struct {
+	Id         int64    `json:"id"`
+	NotifyId   int64    `json:"notifyId"`
+	EventKey   string   `json:"eventKey"`
+	NotifyName string   `json:"notifyName"`
+	Template   string   `json:"template"`
+	Variables  []string `json:"variables"`
+	Status     string   `json:"status"`
+}
+
+type LinenotifyTemplateCreateBody struct {
+	NotifyId int64  `json:"notifyId" validate:"required"`
+	Template string `json:"template" validate:"required,max=1000" example:"ฝากเงิน {{memberCode}} จำนวน {{amount}} บาท เข้าบัญชี {{accountNumber}}"`
+	Status   string `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+}
+
+type LinenotifyTemplateUpdateBody struct {
+	Template *string `json:"template" validate:"omitempty,max=1000"`
+	Status   *string `json:"status" enums:"ACTIVE,DEACTIVE"`
+}
+
+type LinenotifyEvent struct {
+	EventKey      string     `json:"eventKey" validate:"required" enums:"DEPOSIT_NEW,WITHDRAW_REQUEST,CREDIT_LOW,BANK_DISCONNECTED"`
+	MemberCode    string     `json:"memberCode"`
+	Amount        float32    `json:"amount"`
+	Balance       float64    `json:"balance"`
+	BankName      string     `json:"bankName"`
+	AccountName   string     `json:"accountName"`
+	AccountNumber string     `json:"accountNumber"`
+	Remark        string     `json:"remark"`
+	OccurredAt    *time.Time `json:"occurredAt"`
+}
+
+type LinenotifyTestBody struct {
+	NotifyId int64           `json:"notifyId" validate:"required"`
+	Event    LinenotifyEvent `json:"event"`
+}
+
+type LinenotifyOauthState struct {
+	Id               int64      `json:"id"`
+	TenantId         int64      `json:"tenantId"`
+	State            string     `json:"state"`
+	UserId           int64      `json:"userId"`
+	LinenotifyGameId int64      `json:"linenotifyGameId"`
+	TypeNotifyId     string     `json:"typeNotifyId"`
+	ExpiredAt        time.Time  `json:"expiredAt"`
+	UsedAt           *time.Time `json:"usedAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+type LinenotifyAuthorizeRequest struct {
+	LinenotifyGameId int64  `json:"linenotifyGameId" validate:"required"`
+	TypeNotifyId     string `json:"typeNotifyId" validate:"required"`
+	UserId           int64  `json:"-"`
+}
+
+type LinenotifyAuthorizeResponse struct {
+	AuthorizeUrl string    `json:"authorizeUrl"`
+	State        string    `json:"state"`
+	ExpiredAt    time.Time `json:"expiredAt"`
+}
+
+type LinenotifyTokenResponse struct {
+	Status      int    `json:"status"`
+	Message     string `json:"message"`
+	AccessToken string `json:"access_token"`
+}
+
+type LinenotifyStatusResponse struct {
+	Status     int    `json:"status"`
+	Message    string `json:"message"`
+	TargetType string `json:"targetType"`
+	Target     string `json:"target"`
+}
+
+type LinenotifyRevokeResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
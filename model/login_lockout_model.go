@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+)
+
+type LoginLockout struct {
+	Id                 int64      `json:"id"`
+	TenantId           int64      `json:"tenantId"`
+	ActorType          string     `json:"actorType"`
+	KeyType            string     `json:"keyType"`
+	KeyValue           string     `json:"keyValue"`
+	FailedCount        int        `json:"failedCount"`
+	LockCount          int        `json:"lockCount"`
+	LockedAt           *time.Time `json:"lockedAt"`
+	LockedUntil        *time.Time `json:"lockedUntil"`
+	UnlockRemark       string     `json:"unlockRemark"`
+	UnlockedAt         *time.Time `json:"unlockedAt"`
+	UnlockedByUserId   int64      `json:"unlockedByUserId"`
+	UnlockedByUsername string     `json:"unlockedByUsername"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type LoginLockoutSetting struct {
+	Id                   int64      `json:"id"`
+	TenantId             int64      `json:"tenantId"`
+	ActorType            string     `json:"actorType"`
+	WindowMinutes        int        `json:"windowMinutes"`
+	MaxFailedPerUsername int        `json:"maxFailedPerUsername"`
+	MaxFailedPerIp       int        `json:"maxFailedPerIp"`
+	LockMinutes          int        `json:"lockMinutes"`
+	MaxLockMinutes       int        `json:"maxLockMinutes"`
+	LockResetDays        int        `json:"lockResetDays"`
+	DelayBaseMs          int        `json:"delayBaseMs"`
+	DelayMaxMs           int        `json:"delayMaxMs"`
+	NotifyOnLock         bool       `json:"notifyOnLock"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	UpdatedAt            *time.Time `json:"updatedAt"`
+}
+
+type LoginLockoutSettingUpdateBody struct {
+	ActorType            string `json:"actorType" validate:"required" enums:"ADMIN,USER"`
+	WindowMinutes        *int   `json:"windowMinutes" validate:"omitempty,min=1" example:"15"`
+	MaxFailedPerUsername *int   `json:"maxFailedPerUsername" validate:"omitempty,min=1" example:"5"`
+	MaxFailedPerIp       *int   `json:"maxFailedPerIp" validate:"omitempty,min=1" example:"20"`
+	LockMinutes          *int   `json:"lockMinutes" validate:"omitempty,min=1" example:"15"`
+	MaxLockMinutes       *int   `json:"maxLockMinutes" validate:"omitempty,min=1" example:"1440"`
+	LockResetDays        *int   `json:"lockResetDays" validate:"omitempty,min=1" example:"7"`
+	DelayBaseMs          *int   `json:"delayBaseMs" validate:"omitempty,min=0" example:"250"`
+	DelayMaxMs           *int   `json:"delayMaxMs" validate:"omitempty,min=0" example:"5000"`
+	NotifyOnLock         *bool  `json:"notifyOnLock"`
+}
+
+type LoginLockoutListRequest struct {
+	ActorType string `form:"actorType" extensions:"x-order:1"`
+	KeyType   string `form:"keyType" extensions:"x-order:2"`
+	Active    bool   `form:"active" extensions:"x-order:3" default:"true"`
+	Search    string `form:"search" extensions:"x-order:4"`
+	Page      int    `form:"page" extensions:"x-order:5" default:"1" min:"1"`
+	Limit     int    `form:"limit" extensions:"x-order:6" default:"10" min:"1" max:"100"`
+	SortCol   string `form:"sortCol" extensions:"x-order:7"`
+	SortAsc   string `form:"sortAsc" extensions:"x-order:8"`
+}
+
+type LoginLockoutUnlockBody struct {
+	UnlockRemark       string    `json:"unlockRemark" validate:"required,max=255"`
+	UnlockedAt         time.Time `json:"-"`
+	UnlockedByUserId   int64     `json:"-"`
+	UnlockedByUsername string    `json:"-"`
+}
+
+type LoginLockoutStatus struct {
+	IsLocked    bool       `json:"isLocked"`
+	LockedUntil *time.Time `json:"lockedUntil"`
+	FailedCount int        `json:"failedCount"`
+	RemainCount int        `json:"remainCount"`
+	DelayMs     int        `json:"delayMs"`
+}
+
+func (l LoginLockout) IsActive(now time.Time) bool {
+	return l.UnlockedAt == nil && l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
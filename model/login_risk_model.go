@@ -0,0 +1,118 @@
+package model
+
+import (
+	"time"
+)
+
+type LoginAttempt struct {
+	Id        int64     `json:"id"`
+	TenantId  int64     `json:"tenantId"`
+	ActorType string    `json:"actorType"`
+	ActorId   *int64    `json:"actorId"`
+	Username  string    `json:"username"`
+	Ip        string    `json:"ip"`
+	IsSuccess bool      `json:"isSuccess"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type LoginAttemptCreateBody struct {
+	ActorType string `json:"actorType" validate:"required" enums:"ADMIN,USER"`
+	ActorId   *int64 `json:"actorId"`
+	Username  string `json:"username" validate:"required"`
+	Ip        string `json:"ip"`
+	IsSuccess bool   `json:"isSuccess"`
+	Reason    string `json:"reason"`
+}
+
+type IpLocation struct {
+	Ip          string  `json:"ip"`
+	CountryCode string  `json:"countryCode"`
+	CountryName string  `json:"countryName"`
+	City        string  `json:"city"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	TimeZone    string  `json:"timeZone"`
+}
+
+type LoginRiskEvent struct {
+	Id                  int64      `json:"id"`
+	TenantId            int64      `json:"tenantId"`
+	ActorType           string     `json:"actorType"`
+	ActorId             int64      `json:"actorId"`
+	Username            string     `json:"username"`
+	EventType           string     `json:"eventType"`
+	Ip                  string     `json:"ip"`
+	PreviousIp          string     `json:"previousIp"`
+	CountryCode         string     `json:"countryCode"`
+	City                string     `json:"city"`
+	PreviousCountryCode string     `json:"previousCountryCode"`
+	PreviousCity        string     `json:"previousCity"`
+	DistanceKm          float64    `json:"distanceKm"`
+	SpeedKmh            float64    `json:"speedKmh"`
+	Score               float32    `json:"score" sql:"type:decimal(8,2);"`
+	Detail              string     `json:"detail"`
+	Status              string     `json:"status"`
+	ReviewRemark        string     `json:"reviewRemark"`
+	ReviewedAt          *time.Time `json:"reviewedAt"`
+	ReviewedByUserId    int64      `json:"reviewedByUserId"`
+	ReviewedByUsername  string     `json:"reviewedByUsername"`
+	OccurredAt          time.Time  `json:"occurredAt"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           *time.Time `json:"updatedAt"`
+}
+
+type LoginRiskEventListRequest struct {
+	ActorType string `form:"actorType" extensions:"x-order:1"`
+	ActorId   string `form:"actorId" extensions:"x-order:2"`
+	EventType string `form:"eventType" extensions:"x-order:3"`
+	Status    string `form:"status" extensions:"x-order:4"`
+	FromDate  string `form:"fromDate" extensions:"x-order:5"`
+	ToDate    string `form:"toDate" extensions:"x-order:6"`
+	Search    string `form:"search" extensions:"x-order:7"`
+	Page      int    `form:"page" extensions:"x-order:8" default:"1" min:"1"`
+	Limit     int    `form:"limit" extensions:"x-order:9" default:"10" min:"1" max:"100"`
+	SortCol   string `form:"sortCol" extensions:"x-order:10"`
+	SortAsc   string `form:"sortAsc" extensions:"x-order:11"`
+}
+
+type LoginRiskEventReviewBody struct {
+	Status             string    `json:"status" validate:"required" enums:"CONFIRMED,DISMISSED"`
+	ReviewRemark       string    `json:"reviewRemark" validate:"max=255"`
+	ReviewedAt         time.Time `json:"-"`
+	ReviewedByUserId   int64     `json:"-"`
+	ReviewedByUsername string    `json:"-"`
+}
+
+type LoginRiskSetting struct {
+	Id                   int64      `json:"id"`
+	TenantId             int64      `json:"tenantId"`
+	MaxTravelSpeedKmh    float64    `json:"maxTravelSpeedKmh"`
+	UnusualHourStart     int        `json:"unusualHourStart"`
+	UnusualHourEnd       int        `json:"unusualHourEnd"`
+	FailedBurstCount     int        `json:"failedBurstCount"`
+	FailedBurstMinutes   int        `json:"failedBurstMinutes"`
+	NewIpWithdrawMinutes int        `json:"newIpWithdrawMinutes"`
+	BlockWithdrawScore   float32    `json:"blockWithdrawScore" sql:"type:decimal(8,2);"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	UpdatedAt            *time.Time `json:"updatedAt"`
+}
+
+type LoginRiskSettingUpdateBody struct {
+	MaxTravelSpeedKmh    *float64 `json:"maxTravelSpeedKmh" validate:"omitempty,gt=0" example:"900"`
+	UnusualHourStart     *int     `json:"unusualHourStart" validate:"omitempty,min=0,max=23" example:"2"`
+	UnusualHourEnd       *int     `json:"unusualHourEnd" validate:"omitempty,min=0,max=23" example:"6"`
+	FailedBurstCount     *int     `json:"failedBurstCount" validate:"omitempty,min=1" example:"5"`
+	FailedBurstMinutes   *int     `json:"failedBurstMinutes" validate:"omitempty,min=1" example:"10"`
+	NewIpWithdrawMinutes *int     `json:"newIpWithdrawMinutes" validate:"omitempty,min=1" example:"30"`
+	BlockWithdrawScore   *float32 `json:"blockWithdrawScore" validate:"omitempty,min=0"`
+}
+
+type LoginRiskCheckResponse struct {
+	ActorType string           `json:"actorType"`
+	ActorId   int64            `json:"actorId"`
+	Score     float32          `json:"score"`
+	HasRisk   bool             `json:"hasRisk"`
+	Action    string           `json:"action" enums:"ALLOW,FLAG,BLOCK"`
+	Events    []LoginRiskEvent `json:"events"`
+}
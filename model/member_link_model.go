@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+)
+
+type MemberLink struct {
+	Id           int64     `json:"id"`
+	TenantId     int64     `json:"tenantId"`
+	UserId       int64     `json:"userId"`
+	LinkedUserId int64     `json:"linkedUserId"`
+	LinkType     string    `json:"linkType"`
+	LinkValue    string    `json:"linkValue"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type MemberLinkCluster struct {
+	Id                 int64      `json:"id"`
+	TenantId           int64      `json:"tenantId"`
+	MemberCount        int64      `json:"memberCount"`
+	LinkCount          int64      `json:"linkCount"`
+	Score              float32    `json:"score" sql:"type:decimal(8,2);"`
+	Status             string     `json:"status"`
+	FlaggedAt          *time.Time `json:"flaggedAt"`
+	ReviewRemark       string     `json:"reviewRemark"`
+	ReviewedAt         *time.Time `json:"reviewedAt"`
+	ReviewedByUserId   int64      `json:"reviewedByUserId"`
+	ReviewedByUsername string     `json:"reviewedByUsername"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type MemberLinkClusterMember struct {
+	Id        int64     `json:"id"`
+	TenantId  int64     `json:"tenantId"`
+	ClusterId int64     `json:"clusterId"`
+	UserId    int64     `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type MemberLinkSetting struct {
+	Id            int64      `json:"id"`
+	TenantId      int64      `json:"tenantId"`
+	LinkType      string     `json:"linkType"`
+	Weight        float32    `json:"weight" sql:"type:decimal(8,2);"`
+	IsEnabled     bool       `json:"isEnabled"`
+	AutoFlagScore float32    `json:"autoFlagScore" sql:"type:decimal(8,2);"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt"`
+}
+
+type MemberLinkSettingUpdateBody struct {
+	LinkType      string   `json:"linkType" validate:"required" enums:"IP,IP_REGISTERED,BANK_ACCOUNT,TRUE_WALLET,PHONE,DEVICE"`
+	Weight        *float32 `json:"weight" validate:"omitempty,min=0"`
+	IsEnabled     *bool    `json:"isEnabled"`
+	AutoFlagScore *float32 `json:"autoFlagScore" validate:"omitempty,min=0"`
+}
+
+type MemberLinkClusterListRequest struct {
+	Status   string   `form:"status" extensions:"x-order:1"`
+	MinScore *float32 `form:"minScore" extensions:"x-order:2"`
+	Search   string   `form:"search" extensions:"x-order:3"`
+	Page     int      `form:"page" extensions:"x-order:4" default:"1" min:"1"`
+	Limit    int      `form:"limit" extensions:"x-order:5" default:"10" min:"1" max:"100"`
+	SortCol  string   `form:"sortCol" extensions:"x-order:6"`
+	SortAsc  string   `form:"sortAsc" extensions:"x-order:7"`
+}
+
+type MemberLinkClusterResponse struct {
+	Id                 int64                 `json:"id"`
+	MemberCount        int64                 `json:"memberCount"`
+	LinkCount          int64                 `json:"linkCount"`
+	Score              float32               `json:"score"`
+	Status             string                `json:"status"`
+	FlaggedAt          *time.Time            `json:"flaggedAt"`
+	ReviewRemark       string                `json:"reviewRemark"`
+	ReviewedAt         *time.Time            `json:"reviewedAt"`
+	ReviewedByUsername string                `json:"reviewedByUsername"`
+	Members            []MemberLinkedAccount `json:"members"`
+	CreatedAt          time.Time             `json:"createdAt"`
+}
+
+type MemberLinkClusterReviewBody struct {
+	Status             string    `json:"status" validate:"required" enums:"FLAGGED,CLEARED"`
+	ReviewRemark       string    `json:"reviewRemark" validate:"required,max=255"`
+	ReviewedAt         time.Time `json:"-"`
+	ReviewedByUserId   int64     `json:"-"`
+	ReviewedByUsername string    `json:"-"`
+}
+
+type MemberLinkedAccount struct {
+	UserId     int64     `json:"userId"`
+	MemberCode string    `json:"memberCode"`
+	Fullname   string    `json:"fullname"`
+	Status     string    `json:"status"`
+	LinkTypes  []string  `json:"linkTypes"`
+	Score      float32   `json:"score"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type MemberLinkCheckResponse struct {
+	UserId         int64                 `json:"userId"`
+	ClusterId      *int64                `json:"clusterId"`
+	Score          float32               `json:"score"`
+	IsFlagged      bool                  `json:"isFlagged"`
+	LinkedAccounts []MemberLinkedAccount `json:"linkedAccounts"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+)
+
+type NameVerification struct {
+	Id                    int64     `json:"id"`
+	TenantId              int64     `json:"tenantId"`
+	UserId                int64     `json:"userId"`
+	BankCode              string    `json:"bankCode"`
+	BankAccount           string    `json:"bankAccount"`
+	InputName             string    `json:"inputName"`
+	AccountToName         string    `json:"accountToName"`
+	NormalizedInputName   string    `json:"normalizedInputName"`
+	NormalizedAccountName string    `json:"normalizedAccountName"`
+	MatchScore            float32   `json:"matchScore" sql:"type:decimal(5,2);"`
+	Status                string    `json:"status"`
+	Remark                string    `json:"remark"`
+	CreatedByUsername     string    `json:"createdByUsername"`
+	CreatedAt             time.Time `json:"createdAt"`
+}
+
+type NameVerifyRequest struct {
+	UserId      int64  `json:"userId" validate:"required"`
+	Fullname    string `json:"fullname"`
+	BankCode    string `json:"bankCode"`
+	BankAccount string `json:"bankAccount"`
+}
+
+type NameVerifyResponse struct {
+	UserId                int64   `json:"userId"`
+	InputName             string  `json:"inputName"`
+	AccountToName         string  `json:"accountToName"`
+	NormalizedInputName   string  `json:"normalizedInputName"`
+	NormalizedAccountName string  `json:"normalizedAccountName"`
+	MatchScore            float32 `json:"matchScore"`
+	Status                string  `json:"status" enums:"VERIFIED,MISMATCH,PENDING,ERROR"`
+}
+
+type NameVerifyOverrideBody struct {
+	Status            string `json:"status" validate:"required" enums:"VERIFIED,MISMATCH"`
+	Remark            string `json:"remark" validate:"required,max=255"`
+	CreatedByUsername string `json:"-"`
+}
+
+type NameVerificationListRequest struct {
+	UserId  string `form:"userId" extensions:"x-order:1"`
+	Status  string `form:"status" extensions:"x-order:2"`
+	Search  string `form:"search" extensions:"x-order:3"`
+	Page    int    `form:"page" extensions:"x-order:4" default:"1" min:"1"`
+	Limit   int    `form:"limit" extensions:"x-order:5" default:"10" min:"1" max:"100"`
+	SortCol string `form:"sortCol" extensions:"x-order:6"`
+	SortAsc string `form:"sortAsc" extensions:"x-order:7"`
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotifyChannel struct {
+	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
+	Name               string         `json:"name"`
+	ChannelType        string         `json:"channelType"`
+	NotifyId           int64          `json:"notifyId"`
+	LinenotifyId       *int64         `json:"linenotifyId"`
+	Token              string         `json:"-"`
+	Target             string         `json:"target"`
+	WebhookUrl         string         `json:"webhookUrl"`
+	Secret             string         `json:"-"`
+	StartCredit        float32        `json:"startcredit" sql:"type:decimal(14,2);"`
+	RateLimitPerMinute int            `json:"rateLimitPerMinute"`
+	Status             string         `json:"status"`
+	CreatedAt          time.Time      `json:"createdAt"`
+	UpdatedAt          *time.Time     `json:"updatedAt"`
+	DeletedAt          gorm.DeletedAt `json:"deletedAt"`
+}
+
+type NotifyChannelResponse struct {
+	Id                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	ChannelType        string     `json:"channelType"`
+	NotifyId           int64      `json:"notifyId"`
+	NotifyName         string     `json:"notifyName"`
+	LinenotifyId       *int64     `json:"linenotifyId"`
+	Target             string     `json:"target"`
+	WebhookUrl         string     `json:"webhookUrl"`
+	HasToken           bool       `json:"hasToken"`
+	HasSecret          bool       `json:"hasSecret"`
+	StartCredit        float32    `json:"startcredit"`
+	RateLimitPerMinute int        `json:"rateLimitPerMinute"`
+	Status             string     `json:"status"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type NotifyChannelListRequest struct {
+	ChannelType string `form:"channelType"`
+	NotifyId    string `form:"notifyId"`
+	Status      string `form:"status"`
+	Page        int    `form:"page" default:"1" min:"1"`
+	Limit       int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search      string `form:"search"`
+	SortCol     string `form:"sortCol"`
+	SortAsc     string `form:"sortAsc"`
+}
+
+type NotifyChannelCreateBody struct {
+	Name               string  `json:"name" validate:"required,max=255"`
+	ChannelType        string  `json:"channelType" validate:"required,oneof=LINE_MESSAGING TELEGRAM DISCORD SLACK WEBHOOK" enums:"LINE_MESSAGING,TELEGRAM,DISCORD,SLACK,WEBHOOK" example:"TELEGRAM"`
+	NotifyId           int64   `json:"notifyId" validate:"required"`
+	Token              string  `json:"token" validate:"required_if=ChannelType LINE_MESSAGING,required_if=ChannelType TELEGRAM"`
+	Target             string  `json:"target" validate:"required_if=ChannelType LINE_MESSAGING,required_if=ChannelType TELEGRAM" example:"-1001234567890"`
+	WebhookUrl         string  `json:"webhookUrl" validate:"required_if=ChannelType DISCORD,required_if=ChannelType SLACK,required_if=ChannelType WEBHOOK,omitempty,url"`
+	Secret             string  `json:"secret"`
+	StartCredit        float32 `json:"startcredit"`
+	RateLimitPerMinute int     `json:"rateLimitPerMinute" validate:"min=0" example:"60"`
+	Status             string  `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+}
+
+type NotifyChannelUpdateBody struct {
+	Name               *string  `json:"name" validate:"omitempty,max=255"`
+	NotifyId           *int64   `json:"notifyId"`
+	Token              *string  `json:"token"`
+	Target             *string  `json:"target"`
+	WebhookUrl         *string  `json:"webhookUrl" validate:"omitempty,url"`
+	Secret             *string  `json:"secret"`
+	StartCredit        *float32 `json:"startcredit"`
+	RateLimitPerMinute *int     `json:"rateLimitPerMinute" validate:"omitempty,min=0"`
+	Status             *string  `json:"status" enums:"ACTIVE,DEACTIVE"`
+}
+
+type NotifyMessage struct {
+	ChannelId int64  `json:"channelId"`
+	NotifyId  int64  `json:"notifyId"`
+	Title     string `json:"title"`
+	Message   string `json:"message" validate:"required"`
+	ImageUrl  string `json:"imageUrl"`
+}
+
+type NotifyChannelTestBody struct {
+	Message string `json:"message" validate:"required,max=1000"`
+}
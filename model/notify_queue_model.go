@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+)
+
+type NotifyQueue struct {
+	Id            int64      `json:"id"`
+	TenantId      int64      `json:"tenantId"`
+	TargetType    string     `json:"targetType"`
+	TargetId      int64      `json:"targetId"`
+	ChannelType   string     `json:"channelType"`
+	NotifyId      int64      `json:"notifyId"`
+	EventKey      string     `json:"eventKey"`
+	Title         string     `json:"title"`
+	Message       string     `json:"message"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	MaxAttempts   int        `json:"maxAttempts"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	LastError     string     `json:"lastError"`
+	DeadReason    string     `json:"deadReason"`
+	SentAt        *time.Time `json:"sentAt"`
+	DeadAt        *time.Time `json:"deadAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt"`
+}
+
+type NotifyQueueCreateBody struct {
+	TargetType  string `json:"targetType" validate:"required" enums:"LINENOTIFY,LINENOTIFY_USERGAME,CHANNEL"`
+	TargetId    int64  `json:"targetId" validate:"required"`
+	ChannelType string `json:"channelType"`
+	NotifyId    int64  `json:"notifyId"`
+	EventKey    string `json:"eventKey"`
+	Title       string `json:"title"`
+	Message     string `json:"message" validate:"required"`
+	MaxAttempts int    `json:"maxAttempts" default:"5"`
+}
+
+type NotifyQueueListRequest struct {
+	TargetType      string `form:"targetType" extensions:"x-order:1"`
+	ChannelType     string `form:"channelType" extensions:"x-order:2"`
+	Status          string `form:"status" extensions:"x-order:3"`
+	FromCreatedDate string `form:"fromCreatedDate" extensions:"x-order:4"`
+	ToCreatedDate   string `form:"toCreatedDate" extensions:"x-order:5"`
+	Search          string `form:"search" extensions:"x-order:6"`
+	Page            int    `form:"page" extensions:"x-order:7" default:"1" min:"1"`
+	Limit           int    `form:"limit" extensions:"x-order:8" default:"10" min:"1" max:"100"`
+	SortCol         string `form:"sortCol" extensions:"x-order:9"`
+	SortAsc         string `form:"sortAsc" extensions:"x-order:10"`
+}
+
+type NotifyQueueResendBody struct {
+	Ids []int64 `json:"ids" validate:"required,min=1"`
+}
+
+type NotifyQueueSummary struct {
+	PendingCount int64 `json:"pendingCount"`
+	SentCount    int64 `json:"sentCount"`
+	FailedCount  int64 `json:"failedCount"`
+	DeadCount    int64 `json:"deadCount"`
+}
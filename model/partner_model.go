@@ -0,0 +1,217 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Partner struct {
+	Id               int64          `json:"id"`
+	TenantId         int64          `json:"tenantId"`
+	UserId           int64          `json:"userId"`
+	ParentId         *int64         `json:"parentId"`
+	Code             string         `json:"code"`
+	Level            int            `json:"level"`
+	CommissionPlanId int64          `json:"commissionPlanId"`
+	Status           string         `json:"status"`
+	CreatedAt        time.Time      `json:"createdAt"`
+	UpdatedAt        *time.Time     `json:"updatedAt"`
+	DeletedAt        gorm.DeletedAt `json:"deletedAt"`
+}
+
+type PartnerCreateBody struct {
+	UserId           int64  `json:"userId" validate:"required"`
+	ParentId         *int64 `json:"parentId"`
+	Code             string `json:"code" validate:"required,max=20"`
+	CommissionPlanId int64  `json:"commissionPlanId" validate:"required"`
+	Status           string `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+}
+
+type PartnerUpdateBody struct {
+	ParentId         *int64  `json:"parentId"`
+	CommissionPlanId *int64  `json:"commissionPlanId"`
+	Status           *string `json:"status" enums:"ACTIVE,DEACTIVE"`
+}
+
+type PartnerListRequest struct {
+	ParentId *int64 `form:"parentId"`
+	Status   string `form:"status"`
+	Page     int    `form:"page" default:"1" min:"1"`
+	Limit    int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search   string `form:"search"`
+	SortCol  string `form:"sortCol"`
+	SortAsc  string `form:"sortAsc"`
+}
+
+type PartnerResponse struct {
+	Id                 int64      `json:"id"`
+	UserId             int64      `json:"userId"`
+	MemberCode         string     `json:"memberCode"`
+	Fullname           string     `json:"fullname"`
+	ParentId           *int64     `json:"parentId"`
+	ParentCode         string     `json:"parentCode"`
+	Code               string     `json:"code"`
+	Level              int        `json:"level"`
+	CommissionPlanId   int64      `json:"commissionPlanId"`
+	CommissionPlanName string     `json:"commissionPlanName"`
+	MemberCount        int64      `json:"memberCount"`
+	Status             string     `json:"status"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type PartnerTree struct {
+	Id       int64          `json:"id"`
+	Code     string         `json:"code"`
+	Level    int            `json:"level"`
+	Children *[]PartnerTree `json:"children"`
+}
+
+type CommissionPlan struct {
+	Id           int64          `json:"id"`
+	TenantId     int64          `json:"tenantId"`
+	Name         string         `json:"name"`
+	CommissionBy string         `json:"commissionBy"`
+	PeriodType   string         `json:"periodType"`
+	MinPayout    float32        `json:"minPayout" sql:"type:decimal(14,2);"`
+	MaxLevel     int            `json:"maxLevel"`
+	Status       string         `json:"status"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    *time.Time     `json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt `json:"deletedAt"`
+}
+
+type CommissionPlanLevel struct {
+	Id               int64   `json:"id"`
+	CommissionPlanId int64   `json:"commissionPlanId"`
+	Level            int     `json:"level"`
+	Percent          float32 `json:"percent" sql:"type:decimal(5,2);"`
+}
+
+type CommissionPlanLevelBody struct {
+	Level   int     `json:"level" validate:"required,min=1"`
+	Percent float32 `json:"percent" validate:"required,gt=0,lte=100"`
+}
+
+type CommissionPlanCreateBody struct {
+	Name         string                    `json:"name" validate:"required,max=255"`
+	CommissionBy string                    `json:"commissionBy" validate:"required" enums:"NET_LOSS,DEPOSIT,TURNOVER" example:"NET_LOSS"`
+	PeriodType   string                    `json:"periodType" validate:"required" enums:"DAILY,WEEKLY,MONTHLY" example:"MONTHLY"`
+	MinPayout    float32                   `json:"minPayout"`
+	Status       string                    `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+	Levels       []CommissionPlanLevelBody `json:"levels" validate:"required,min=1,dive"`
+}
+
+type CommissionPlanUpdateBody struct {
+	Name         *string                    `json:"name" validate:"omitempty,max=255"`
+	CommissionBy *string                    `json:"commissionBy" enums:"NET_LOSS,DEPOSIT,TURNOVER"`
+	PeriodType   *string                    `json:"periodType" enums:"DAILY,WEEKLY,MONTHLY"`
+	MinPayout    *float32                   `json:"minPayout"`
+	Status       *string                    `json:"status" enums:"ACTIVE,DEACTIVE"`
+	Levels       *[]CommissionPlanLevelBody `json:"levels" validate:"omitempty,min=1,dive"`
+}
+
+type CommissionPlanResponse struct {
+	Id           int64                 `json:"id"`
+	Name         string                `json:"name"`
+	CommissionBy string                `json:"commissionBy"`
+	PeriodType   string                `json:"periodType"`
+	MinPayout    float32               `json:"minPayout"`
+	MaxLevel     int                   `json:"maxLevel"`
+	Status       string                `json:"status"`
+	Levels       []CommissionPlanLevel `json:"levels"`
+}
+
+type CommissionPlanListRequest struct {
+	Status  string `form:"status"`
+	Page    int    `form:"page" default:"1" min:"1"`
+	Limit   int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search  string `form:"search"`
+	SortCol string `form:"sortCol"`
+	SortAsc string `form:"sortAsc"`
+}
+
+type CommissionStatement struct {
+	Id                  int64          `json:"id"`
+	TenantId            int64          `json:"tenantId"`
+	PartnerId           int64          `json:"partnerId"`
+	CommissionPlanId    int64          `json:"commissionPlanId"`
+	CommissionBy        string         `json:"commissionBy"`
+	FromDate            time.Time      `json:"fromDate"`
+	ToDate              time.Time      `json:"toDate"`
+	MemberCount         int64          `json:"memberCount"`
+	BaseAmount          float32        `json:"baseAmount" sql:"type:decimal(14,2);"`
+	CommissionAmount    float32        `json:"commissionAmount" sql:"type:decimal(14,2);"`
+	PayType             string         `json:"payType"`
+	PaidTransactionId   *int64         `json:"paidTransactionId"`
+	PaidTransferId      *int64         `json:"paidTransferId"`
+	Status              string         `json:"status"`
+	ConfirmedAt         *time.Time     `json:"confirmedAt"`
+	ConfirmedByUserId   int64          `json:"confirmedByUserId"`
+	ConfirmedByUsername string         `json:"confirmedByUsername"`
+	CreatedAt           time.Time      `json:"createdAt"`
+	UpdatedAt           *time.Time     `json:"updatedAt"`
+	DeletedAt           gorm.DeletedAt `json:"deletedAt"`
+}
+
+type CommissionStatementItem struct {
+	Id                    int64   `json:"id"`
+	CommissionStatementId int64   `json:"commissionStatementId"`
+	UserId                int64   `json:"userId"`
+	MemberCode            string  `json:"memberCode"`
+	Level                 int     `json:"level"`
+	DepositAmount         float32 `json:"depositAmount" sql:"type:decimal(14,2);"`
+	WithdrawAmount        float32 `json:"withdrawAmount" sql:"type:decimal(14,2);"`
+	BonusAmount           float32 `json:"bonusAmount" sql:"type:decimal(14,2);"`
+	TurnoverAmount        float32 `json:"turnoverAmount" sql:"type:decimal(14,2);"`
+	BaseAmount            float32 `json:"baseAmount" sql:"type:decimal(14,2);"`
+	Percent               float32 `json:"percent" sql:"type:decimal(5,2);"`
+	CommissionAmount      float32 `json:"commissionAmount" sql:"type:decimal(14,2);"`
+}
+
+type CommissionCalculateRequest struct {
+	PartnerId *int64    `json:"partnerId"`
+	FromDate  time.Time `json:"fromDate" validate:"required" example:"2023-05-01T00:00:00+07:00"`
+	ToDate    time.Time `json:"toDate" validate:"required" example:"2023-05-31T23:59:59+07:00"`
+}
+
+type CommissionStatementListRequest struct {
+	PartnerId string `form:"partnerId" extensions:"x-order:1"`
+	FromDate  string `form:"fromDate" extensions:"x-order:2"`
+	ToDate    string `form:"toDate" extensions:"x-order:3"`
+	Status    string `form:"status" extensions:"x-order:4"`
+	Page      int    `form:"page" extensions:"x-order:5" default:"1" min:"1"`
+	Limit     int    `form:"limit" extensions:"x-order:6" default:"10" min:"1" max:"100"`
+	SortCol   string `form:"sortCol" extensions:"x-order:7"`
+	SortAsc   string `form:"sortAsc" extensions:"x-order:8"`
+}
+
+type CommissionStatementResponse struct {
+	Id                  int64                     `json:"id"`
+	PartnerId           int64                     `json:"partnerId"`
+	PartnerCode         string                    `json:"partnerCode"`
+	MemberCode          string                    `json:"memberCode"`
+	CommissionPlanId    int64                     `json:"commissionPlanId"`
+	CommissionBy        string                    `json:"commissionBy"`
+	FromDate            time.Time                 `json:"fromDate"`
+	ToDate              time.Time                 `json:"toDate"`
+	MemberCount         int64                     `json:"memberCount"`
+	BaseAmount          float32                   `json:"baseAmount"`
+	CommissionAmount    float32                   `json:"commissionAmount"`
+	PayType             string                    `json:"payType"`
+	Status              string                    `json:"status"`
+	ConfirmedAt         *time.Time                `json:"confirmedAt"`
+	ConfirmedByUsername string                    `json:"confirmedByUsername"`
+	Items               []CommissionStatementItem `json:"items"`
+	CreatedAt           time.Time                 `json:"createdAt"`
+}
+
+type CommissionStatementPayBody struct {
+	PayType             string     `json:"payType" validate:"required,oneof=BONUS BANK_TRANSFER" enums:"BONUS,BANK_TRANSFER" example:"BONUS"`
+	FromAccountId       *int64     `json:"fromAccountId" validate:"required_if=PayType BANK_TRANSFER"`
+	TransferAt          *time.Time `json:"transferAt" validate:"required_if=PayType BANK_TRANSFER" example:"2023-05-31T22:33:44+07:00"`
+	ConfirmedAt         time.Time  `json:"-"`
+	ConfirmedByUserId   int64      `json:"-"`
+	ConfirmedByUsername string     `json:"-"`
+}
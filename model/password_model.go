@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+)
+
+type PasswordPolicy struct {
+	Id               int64      `json:"id"`
+	TenantId         int64      `json:"tenantId"`
+	ActorType        string     `json:"actorType"`
+	MinLength        int        `json:"minLength"`
+	MaxLength        int        `json:"maxLength"`
+	RequireUpper     bool       `json:"requireUpper"`
+	RequireLower     bool       `json:"requireLower"`
+	RequireDigit     bool       `json:"requireDigit"`
+	RequireSymbol    bool       `json:"requireSymbol"`
+	CheckBreached    bool       `json:"checkBreached"`
+	DisallowUsername bool       `json:"disallowUsername"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        *time.Time `json:"updatedAt"`
+}
+
+type PasswordPolicyUpdateBody struct {
+	ActorType        string `json:"actorType" validate:"required" enums:"ADMIN,USER"`
+	MinLength        *int   `json:"minLength" validate:"omitempty,min=8,max=128"`
+	MaxLength        *int   `json:"maxLength" validate:"omitempty,min=8,max=128"`
+	RequireUpper     *bool  `json:"requireUpper"`
+	RequireLower     *bool  `json:"requireLower"`
+	RequireDigit     *bool  `json:"requireDigit"`
+	RequireSymbol    *bool  `json:"requireSymbol"`
+	CheckBreached    *bool  `json:"checkBreached"`
+	DisallowUsername *bool  `json:"disallowUsername"`
+}
+
+type PasswordCheckRequest struct {
+	ActorType string `json:"actorType" validate:"required" enums:"ADMIN,USER"`
+	Username  string `json:"username"`
+	Password  string `json:"password" validate:"required"`
+}
+
+type PasswordCheckResponse struct {
+	IsValid    bool     `json:"isValid"`
+	IsBreached bool     `json:"isBreached"`
+	Violations []string `json:"violations" enums:"MIN_LENGTH,MAX_LENGTH,UPPER,LOWER,DIGIT,SYMBOL,BREACHED,USERNAME"`
+}
@@ -8,7 +8,10 @@ type Permission struct {
 	Id            int64      `json:"id"`
 	PermissionKey string     `json:"permissionKey"`
 	Name          string     `json:"name"`
+	Title         string     `json:"title"`
 	Main          bool       `json:"main"`
+	ParentId      *int64     `json:"parentId"`
+	Position      int        `json:"position"`
 	CreatedAt     time.Time  `json:"createdAt"`
 	UpdatedAt     time.Time  `json:"updatedAt"`
 	DeletedAt     *time.Time `json:"deleteAt"`
@@ -21,6 +24,9 @@ type CreatePermission struct {
 type PermissionName struct {
 	Name          string `json:"name" validate:"required"`
 	PermissionKey string `json:"permissionKey" validate:"required"`
+	Title         string `json:"title"`
+	ParentKey     string `json:"parentKey"`
+	Position      int    `json:"position"`
 	Main          bool   `json:"isMain" default:"false"`
 }
 
@@ -37,6 +43,87 @@ type PermissionObj struct {
 	IsWrite bool  `json:"write" default:"false"`
 }
 
+// AdminPermissionOverride sets one admin's override per flag: inherit follows the group, allow and deny
+// replace it. PermissionObj in the admin bodies can only grant, so denies have to come through here.
+type AdminPermissionOverride struct {
+	Id        int64  `json:"id" validate:"required"`
+	ReadMode  string `json:"readMode" validate:"required,oneof=inherit allow deny" enums:"inherit,allow,deny" default:"inherit"`
+	WriteMode string `json:"writeMode" validate:"required,oneof=inherit allow deny" enums:"inherit,allow,deny" default:"inherit"`
+}
+
 type DeletePermission struct {
 	PermissionIds []int64 `json:"permissionIds" validate:"required"`
 }
+
+type EffectivePermission struct {
+	PermissionId  int64  `json:"permissionId"`
+	PermissionKey string `json:"permissionKey"`
+	Name          string `json:"name"`
+	Title         string `json:"title"`
+	Main          bool   `json:"main"`
+	ParentId      *int64 `json:"parentId"`
+	Position      int    `json:"position"`
+	IsRead        bool   `json:"read"`
+	IsWrite       bool   `json:"write"`
+	Source        string `json:"source" enums:"GROUP,ADMIN,NONE"`
+}
+
+type PermissionCheckRequest struct {
+	AdminId       int64  `form:"adminId" json:"adminId" validate:"required"`
+	PermissionKey string `form:"permissionKey" json:"permissionKey" validate:"required"`
+	Action        string `form:"action" json:"action" validate:"required" enums:"read,write" example:"read"`
+}
+
+type PermissionCheckResponse struct {
+	AdminId                int64  `json:"adminId"`
+	PermissionKey          string `json:"permissionKey"`
+	Action                 string `json:"action"`
+	Allowed                bool   `json:"allowed"`
+	Source                 string `json:"source"`
+	PermissionVersion      int64  `json:"permissionVersion"`
+	AdminPermissionVersion int64  `json:"adminPermissionVersion"`
+}
+
+type AdminEffectivePermission struct {
+	AdminId                int64                 `json:"adminId"`
+	GroupId                int64                 `json:"groupId"`
+	PermissionVersion      int64                 `json:"permissionVersion"`
+	AdminPermissionVersion int64                 `json:"adminPermissionVersion"`
+	Permissions            []EffectivePermission `json:"permissions"`
+	Menus                  []Menu                `json:"menus"`
+}
+
+type PermissionCatalogItem struct {
+	PermissionKey string                   `json:"permissionKey"`
+	Name          string                   `json:"name"`
+	Title         string                   `json:"title"`
+	Main          bool                     `json:"main"`
+	Position      int                      `json:"position"`
+	FormerKeys    []string                 `json:"formerKeys"`
+	Children      *[]PermissionCatalogItem `json:"children"`
+}
+
+type PermissionSyncRequest struct {
+	DryRun        bool `json:"dryRun" default:"true"`
+	RemoveOrphans bool `json:"removeOrphans" default:"false"`
+}
+
+type PermissionDrift struct {
+	PermissionKey string  `json:"permissionKey"`
+	DriftType     string  `json:"driftType" enums:"MISSING,RENAMED,CHANGED,ORPHANED"`
+	FormerKey     string  `json:"formerKey"`
+	CatalogName   string  `json:"catalogName"`
+	DbName        string  `json:"dbName"`
+	GroupIds      []int64 `json:"groupIds"`
+	AdminIds      []int64 `json:"adminIds"`
+}
+
+type PermissionSyncResponse struct {
+	DryRun    bool              `json:"dryRun"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Renamed   int               `json:"renamed"`
+	Removed   int               `json:"removed"`
+	Unchanged int               `json:"unchanged"`
+	Drifts    []PermissionDrift `json:"drifts"`
+}
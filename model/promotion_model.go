@@ -0,0 +1,190 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Promotion struct {
+	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
+	Name               string         `json:"name"`
+	Description        string         `json:"description"`
+	ImageUrl           string         `json:"imageUrl"`
+	EligibleType       string         `json:"eligibleType"`
+	DepositNumber      int            `json:"depositNumber"`
+	DepositChannel     string         `json:"depositChannel"`
+	MemberTier         string         `json:"memberTier"`
+	MinDepositAmount   float32        `json:"minDepositAmount" sql:"type:decimal(14,2);"`
+	BonusType          string         `json:"bonusType"`
+	BonusValue         float32        `json:"bonusValue" sql:"type:decimal(14,2);"`
+	MaxBonusAmount     float32        `json:"maxBonusAmount" sql:"type:decimal(14,2);"`
+	TurnoverMultiplier float32        `json:"turnoverMultiplier" sql:"type:decimal(8,2);"`
+	MaxClaimPerMember  int            `json:"maxClaimPerMember"`
+	MaxClaimPerDay     int            `json:"maxClaimPerDay"`
+	StartAt            *time.Time     `json:"startAt"`
+	EndAt              *time.Time     `json:"endAt"`
+	DailyStartTime     string         `json:"dailyStartTime"`
+	DailyEndTime       string         `json:"dailyEndTime"`
+	Status             string         `json:"status"`
+	CreatedByUsername  string         `json:"createdByUsername"`
+	CreatedAt          time.Time      `json:"createdAt"`
+	UpdatedAt          *time.Time     `json:"updatedAt"`
+	DeletedAt          gorm.DeletedAt `json:"deletedAt"`
+}
+
+type PromotionGetRequest struct {
+	Id int64 `uri:"id" binding:"required"`
+}
+
+type PromotionListRequest struct {
+	EligibleType string `form:"eligibleType" extensions:"x-order:1"`
+	Status       string `form:"status" extensions:"x-order:2"`
+	Search       string `form:"search" extensions:"x-order:3"`
+	Page         int    `form:"page" extensions:"x-order:4" default:"1" min:"1"`
+	Limit        int    `form:"limit" extensions:"x-order:5" default:"10" min:"1" max:"100"`
+	SortCol      string `form:"sortCol" extensions:"x-order:6"`
+	SortAsc      string `form:"sortAsc" extensions:"x-order:7"`
+}
+
+type PromotionCreateBody struct {
+	Name               string     `json:"name" validate:"required,max=255"`
+	Description        string     `json:"description"`
+	ImageUrl           string     `json:"imageUrl" validate:"omitempty,url"`
+	EligibleType       string     `json:"eligibleType" validate:"required,oneof=FIRST_DEPOSIT NTH_DEPOSIT EVERY_DEPOSIT" enums:"FIRST_DEPOSIT,NTH_DEPOSIT,EVERY_DEPOSIT" example:"FIRST_DEPOSIT"`
+	DepositNumber      int        `json:"depositNumber" validate:"required_if=EligibleType NTH_DEPOSIT,min=0"`
+	DepositChannel     string     `json:"depositChannel"`
+	MemberTier         string     `json:"memberTier" validate:"max=20"`
+	MinDepositAmount   float32    `json:"minDepositAmount" validate:"min=0"`
+	BonusType          string     `json:"bonusType" validate:"required,oneof=PERCENT FIXED" enums:"PERCENT,FIXED" example:"PERCENT"`
+	BonusValue         float32    `json:"bonusValue" validate:"required,gt=0"`
+	MaxBonusAmount     float32    `json:"maxBonusAmount" validate:"min=0"`
+	TurnoverMultiplier float32    `json:"turnoverMultiplier" validate:"min=0"`
+	MaxClaimPerMember  int        `json:"maxClaimPerMember" validate:"min=0"`
+	MaxClaimPerDay     int        `json:"maxClaimPerDay" validate:"min=0"`
+	StartAt            *time.Time `json:"startAt" example:"2023-05-01T00:00:00+07:00"`
+	EndAt              *time.Time `json:"endAt" example:"2023-05-31T23:59:59+07:00"`
+	DailyStartTime     string     `json:"dailyStartTime" validate:"omitempty,datetime=15:04" example:"00:00"`
+	DailyEndTime       string     `json:"dailyEndTime" validate:"omitempty,datetime=15:04" example:"23:59"`
+	Status             string     `json:"status" validate:"required,oneof=ACTIVE DEACTIVE" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+	CreatedByUsername  string     `json:"-"`
+}
+
+type PromotionUpdateBody struct {
+	Name               *string    `json:"name" validate:"omitempty,max=255"`
+	Description        *string    `json:"description"`
+	ImageUrl           *string    `json:"imageUrl" validate:"omitempty,url"`
+	EligibleType       *string    `json:"eligibleType" validate:"omitempty,oneof=FIRST_DEPOSIT NTH_DEPOSIT EVERY_DEPOSIT" enums:"FIRST_DEPOSIT,NTH_DEPOSIT,EVERY_DEPOSIT"`
+	DepositNumber      *int       `json:"depositNumber" validate:"required_if=EligibleType NTH_DEPOSIT,omitempty,min=0"`
+	DepositChannel     *string    `json:"depositChannel"`
+	MemberTier         *string    `json:"memberTier" validate:"omitempty,max=20"`
+	MinDepositAmount   *float32   `json:"minDepositAmount" validate:"omitempty,min=0"`
+	BonusType          *string    `json:"bonusType" validate:"omitempty,oneof=PERCENT FIXED" enums:"PERCENT,FIXED"`
+	BonusValue         *float32   `json:"bonusValue" validate:"omitempty,gt=0"`
+	MaxBonusAmount     *float32   `json:"maxBonusAmount" validate:"omitempty,min=0"`
+	TurnoverMultiplier *float32   `json:"turnoverMultiplier" validate:"omitempty,min=0"`
+	MaxClaimPerMember  *int       `json:"maxClaimPerMember" validate:"omitempty,min=0"`
+	MaxClaimPerDay     *int       `json:"maxClaimPerDay" validate:"omitempty,min=0"`
+	StartAt            *time.Time `json:"startAt"`
+	EndAt              *time.Time `json:"endAt"`
+	DailyStartTime     *string    `json:"dailyStartTime" validate:"omitempty,datetime=15:04"`
+	DailyEndTime       *string    `json:"dailyEndTime" validate:"omitempty,datetime=15:04"`
+	Status             *string    `json:"status" validate:"omitempty,oneof=ACTIVE DEACTIVE" enums:"ACTIVE,DEACTIVE"`
+}
+
+type PromotionResponse struct {
+	Id                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	Description        string     `json:"description"`
+	ImageUrl           string     `json:"imageUrl"`
+	EligibleType       string     `json:"eligibleType"`
+	DepositNumber      int        `json:"depositNumber"`
+	DepositChannel     string     `json:"depositChannel"`
+	MemberTier         string     `json:"memberTier"`
+	MinDepositAmount   float32    `json:"minDepositAmount"`
+	BonusType          string     `json:"bonusType"`
+	BonusValue         float32    `json:"bonusValue"`
+	MaxBonusAmount     float32    `json:"maxBonusAmount"`
+	TurnoverMultiplier float32    `json:"turnoverMultiplier"`
+	MaxClaimPerMember  int        `json:"maxClaimPerMember"`
+	MaxClaimPerDay     int        `json:"maxClaimPerDay"`
+	ClaimCount         int64      `json:"claimCount"`
+	StartAt            *time.Time `json:"startAt"`
+	EndAt              *time.Time `json:"endAt"`
+	DailyStartTime     string     `json:"dailyStartTime"`
+	DailyEndTime       string     `json:"dailyEndTime"`
+	Status             string     `json:"status"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type PromotionClaim struct {
+	Id             int64          `json:"id"`
+	TenantId       int64          `json:"tenantId"`
+	PromotionId    int64          `json:"promotionId"`
+	UserId         int64          `json:"userId"`
+	MemberCode     string         `json:"memberCode"`
+	TransactionId  int64          `json:"transactionId"`
+	DepositNumber  int            `json:"depositNumber"`
+	DepositAmount  float32        `json:"depositAmount" sql:"type:decimal(14,2);"`
+	BonusAmount    float32        `json:"bonusAmount" sql:"type:decimal(14,2);"`
+	BonusReason    string         `json:"bonusReason"`
+	TurnoverAmount float32        `json:"turnoverAmount" sql:"type:decimal(14,2);"`
+	Status         string         `json:"status"`
+	ClaimedAt      time.Time      `json:"claimedAt"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      *time.Time     `json:"updatedAt"`
+	DeletedAt      gorm.DeletedAt `json:"deletedAt"`
+}
+
+type PromotionClaimListRequest struct {
+	PromotionId   string `form:"promotionId" extensions:"x-order:1"`
+	UserId        string `form:"userId" extensions:"x-order:2"`
+	FromClaimDate string `form:"fromClaimDate" extensions:"x-order:3"`
+	ToClaimDate   string `form:"toClaimDate" extensions:"x-order:4"`
+	Status        string `form:"status" extensions:"x-order:5"`
+	Search        string `form:"search" extensions:"x-order:6"`
+	Page          int    `form:"page" extensions:"x-order:7" default:"1" min:"1"`
+	Limit         int    `form:"limit" extensions:"x-order:8" default:"10" min:"1" max:"100"`
+	SortCol       string `form:"sortCol" extensions:"x-order:9"`
+	SortAsc       string `form:"sortAsc" extensions:"x-order:10"`
+}
+
+type PromotionClaimResponse struct {
+	Id             int64     `json:"id"`
+	PromotionId    int64     `json:"promotionId"`
+	PromotionName  string    `json:"promotionName"`
+	UserId         int64     `json:"userId"`
+	MemberCode     string    `json:"memberCode"`
+	UserFullname   string    `json:"userFullname"`
+	TransactionId  int64     `json:"transactionId"`
+	DepositNumber  int       `json:"depositNumber"`
+	DepositAmount  float32   `json:"depositAmount"`
+	BonusAmount    float32   `json:"bonusAmount"`
+	BonusReason    string    `json:"bonusReason"`
+	TurnoverAmount float32   `json:"turnoverAmount"`
+	Status         string    `json:"status"`
+	ClaimedAt      time.Time `json:"claimedAt"`
+}
+
+type PromotionApplyRequest struct {
+	PromotionId    int64     `json:"promotionId" validate:"required"`
+	UserId         int64     `json:"userId" validate:"required"`
+	TransactionId  int64     `json:"transactionId"`
+	DepositAmount  float32   `json:"depositAmount" validate:"required,gt=0"`
+	DepositChannel string    `json:"depositChannel"`
+	TransferAt     time.Time `json:"transferAt" validate:"required" example:"2023-05-31T22:33:44+07:00"`
+	DryRun         bool      `json:"dryRun" default:"false"`
+}
+
+type PromotionApplyResponse struct {
+	PromotionId    int64   `json:"promotionId"`
+	ClaimId        int64   `json:"claimId"`
+	IsEligible     bool    `json:"isEligible"`
+	IneligibleCode string  `json:"ineligibleCode" enums:"NOT_ACTIVE,OUT_OF_PERIOD,OUT_OF_TIME,DEPOSIT_NUMBER,CHANNEL,TIER,MIN_DEPOSIT,CLAIM_LIMIT,DAILY_LIMIT"`
+	DepositNumber  int     `json:"depositNumber"`
+	BonusAmount    float32 `json:"bonusAmount"`
+	BonusReason    string  `json:"bonusReason"`
+	TurnoverAmount float32 `json:"turnoverAmount"`
+}
@@ -6,6 +6,7 @@ import (
 
 type Recommend struct {
 	Id        int64      `json:"id"`
+	TenantId  int64      `json:"tenantId"`
 	Title     *string    `json:"title"`
 	Status    *string    `json:"status"`
 	Url       *string    `json:"url"`
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"time"
+)
+
 type Success struct {
 	Message string `json:"message"`
 }
@@ -21,6 +25,8 @@ type SuccessWithPagination struct {
 }
 
 type SuccessWithToken struct {
-	Message string `json:"message"`
-	Token   string `json:"token"`
+	Message      string     `json:"message"`
+	Token        string     `json:"token"`
+	RefreshToken string     `json:"refreshToken,omitempty"`
+	ExpiredAt    *time.Time `json:"expiredAt,omitempty"`
 }
@@ -1,11 +1,13 @@
 package model
 
 import (
+	"mime/multipart"
 	"time"
 )
 
 type Scammer struct {
 	Id          int64      `json:"id"`
+	TenantId    int64      `json:"tenantId"`
 	Fullname    *string    `json:"fullname"`
 	Firstname   *string    `json:"firstname"`
 	Lastname    *string    `json:"lastname"`
@@ -39,11 +41,59 @@ type ScammerDetail struct {
 	Name string `json:"name"`
 }
 
-type ScammerQuery struct {
-	Page      int        `form:"page" default:"1"`
-	Limit     int        `form:"limit" default:"10"`
-	DateStart *time.Time `form:"dateStart" time_format:"2006-01-02T15:04:05+07:00" default:"2021-01-01T00:00:00+07:00"`
-	DateEnd   *time.Time `form:"dateEnd" time_format:"2006-01-02T15:04:05+07:00" default:"2021-01-01T00:00:00+07:00"`
+// ScammerFilter holds the filters shared by the list and the export.
+type ScammerFilter struct {
+	DateStart *time.Time `form:"dateStart" time_format:"2006-01-02T15:04:05+07:00"`
+	DateEnd   *time.Time `form:"dateEnd" time_format:"2006-01-02T15:04:05+07:00"`
 	BankName  *string    `form:"bankName"`
 	Filter    *string    `form:"filter"`
 }
+
+type ScammerQuery struct {
+	ScammerFilter
+	Page  int `form:"page" default:"1"`
+	Limit int `form:"limit" default:"10"`
+}
+
+type ScammerImportRequest struct {
+	File              *multipart.FileHeader `form:"file" validate:"required"`
+	Format            string                `form:"format" validate:"required" enums:"csv,json" example:"csv"`
+	FullnameColumn    string                `form:"fullnameColumn" default:"fullname"`
+	FirstnameColumn   string                `form:"firstnameColumn" default:"firstname"`
+	LastnameColumn    string                `form:"lastnameColumn" default:"lastname"`
+	BanknameColumn    string                `form:"banknameColumn" default:"bankname"`
+	BankAccountColumn string                `form:"bankAccountColumn" default:"bankAccount"`
+	PhoneColumn       string                `form:"phoneColumn" default:"phone"`
+	ReasonColumn      string                `form:"reasonColumn" default:"reason"`
+	HasHeader         bool                  `form:"hasHeader" default:"true"`
+	DryRun            bool                  `form:"dryRun" default:"false"`
+}
+
+type ScammerImportRow struct {
+	Line        int     `json:"line"`
+	Fullname    *string `json:"fullname"`
+	Firstname   *string `json:"firstname"`
+	Lastname    *string `json:"lastname"`
+	Bankname    *string `json:"bankname"`
+	BankAccount *string `json:"bankAccount"`
+	Phone       *string `json:"phone"`
+	Reason      *string `json:"reason"`
+	Status      string  `json:"status" enums:"CREATED,DUPLICATE,ERROR"`
+	DuplicateId *int64  `json:"duplicateId"`
+	Error       string  `json:"error"`
+}
+
+type ScammerImportResponse struct {
+	Total     int                `json:"total"`
+	Created   int                `json:"created"`
+	Duplicate int                `json:"duplicate"`
+	Failed    int                `json:"failed"`
+	DryRun    bool               `json:"dryRun"`
+	Rows      []ScammerImportRow `json:"rows"`
+}
+
+// ScammerExportQuery exports every matching row; it has no page or limit.
+type ScammerExportQuery struct {
+	ScammerFilter
+	Format string `form:"format" validate:"required" enums:"csv,json" default:"csv"`
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+type TokenClaims struct {
+	SessionId              string `json:"sid"`
+	TenantId               int64  `json:"tid,omitempty"`
+	ActorType              string `json:"act"`
+	AdminId                int64  `json:"adminId,omitempty"`
+	UserId                 int64  `json:"userId,omitempty"`
+	GroupId                int64  `json:"groupId,omitempty"`
+	Role                   string `json:"role,omitempty"`
+	PermissionVersion      int64  `json:"pv,omitempty"`
+	AdminPermissionVersion int64  `json:"apv,omitempty"`
+	TokenType              string `json:"tt"`
+	IssuedAt               int64  `json:"iat"`
+	ExpiredAt              int64  `json:"exp"`
+}
+
+type Session struct {
+	Id                int64      `json:"id"`
+	TenantId          int64      `json:"tenantId"`
+	SessionId         string     `json:"sessionId"`
+	ActorType         string     `json:"actorType"`
+	ActorId           int64      `json:"actorId"`
+	RefreshTokenHash  string     `json:"-"`
+	Ip                string     `json:"ip"`
+	UserAgent         string     `json:"userAgent"`
+	ExpiredAt         time.Time  `json:"expiredAt"`
+	LastUsedAt        *time.Time `json:"lastUsedAt"`
+	RevokedAt         *time.Time `json:"revokedAt"`
+	RevokedReason     string     `json:"revokedReason"`
+	RevokedByUsername string     `json:"revokedByUsername"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+}
+
+type SessionListRequest struct {
+	ActorType string `form:"actorType"`
+	ActorId   string `form:"actorId"`
+	Active    bool   `form:"active" default:"true"`
+	Page      int    `form:"page" default:"1" min:"1"`
+	Limit     int    `form:"limit" default:"10" min:"1" max:"100"`
+	SortCol   string `form:"sortCol"`
+	SortAsc   string `form:"sortAsc"`
+}
+
+type SessionRevokeBody struct {
+	SessionId         *string   `json:"sessionId"`
+	ActorType         string    `json:"actorType" validate:"required" enums:"ADMIN,USER"`
+	ActorId           int64     `json:"actorId" validate:"required"`
+	RevokedReason     string    `json:"revokedReason" validate:"required,max=255" enums:"LOGOUT,STATUS_CHANGED,PERMISSION_CHANGED,PASSWORD_CHANGED,MANUAL"`
+	RevokedAt         time.Time `json:"-"`
+	RevokedByUsername string    `json:"-"`
+}
+
+type RefreshTokenBody struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
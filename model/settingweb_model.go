@@ -1,11 +1,16 @@
 package model
 
 import (
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type Settingweb struct {
 	Id             int64     `json:"id"`
+	TenantId       int64     `json:"tenantId"`
 	Logo           string    `json:"logo"`
 	BackgrondColor string    `json:"backgrondcolor"`
 	UserAuto       string    `json:"userAuto"`
@@ -86,3 +91,213 @@ type SettingwebUpdateBody struct {
 	Url            string `json:"url" validate:"required"`
 	Opt            string `json:"opt" validate:"required"`
 }
+
+// SettingwebConfig is the authoritative web setting. It shares its Id with the legacy Settingweb row,
+// which is kept only for the old string based endpoints and is rewritten from the config on every change.
+type SettingwebConfig struct {
+	Id                int64     `json:"id"`
+	TenantId          int64     `json:"tenantId"`
+	Logo              string    `json:"logo"`
+	BackgroundColor   string    `json:"backgroundColor" default:"#000000"`
+	IsUserAuto        bool      `json:"isUserAuto" default:"false"`
+	IsOtpRegister     bool      `json:"isOtpRegister" default:"false"`
+	IsAutoWithdraw    bool      `json:"isAutoWithdraw" default:"false"`
+	IsTranWithdraw    bool      `json:"isTranWithdraw" default:"false"`
+	IsRegisterEnabled bool      `json:"isRegisterEnabled" default:"true"`
+	MinDepositFirst   float32   `json:"minDepositFirst" sql:"type:decimal(14,2);" default:"100"`
+	MinDepositNext    float32   `json:"minDepositNext" sql:"type:decimal(14,2);" default:"1"`
+	MinWithdraw       float32   `json:"minWithdraw" sql:"type:decimal(14,2);" default:"100"`
+	Line              string    `json:"line"`
+	Url               string    `json:"url"`
+	Opt               string    `json:"opt"`
+	Version           int64     `json:"version"`
+	UpdatedByUsername string    `json:"updatedByUsername"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// NewSettingwebConfig returns a config with the defaults documented on SettingwebConfig. The default tags only
+// feed swagger, so create rows from this rather than a zero value.
+func NewSettingwebConfig(tenantId int64) SettingwebConfig {
+	return SettingwebConfig{
+		TenantId:          tenantId,
+		BackgroundColor:   "#000000",
+		IsRegisterEnabled: true,
+		MinDepositFirst:   100,
+		MinDepositNext:    1,
+		MinWithdraw:       100,
+		Version:           1,
+	}
+}
+
+// SettingwebConfigFromLegacy converts a Settingweb row. Flags accept ACTIVE, true, 1 and on; amounts that
+// do not parse and values that would fail the SettingwebConfigUpdateBody validation keep the default, so a
+// migrated config can always be saved back unchanged.
+func SettingwebConfigFromLegacy(s Settingweb) SettingwebConfig {
+	c := NewSettingwebConfig(s.TenantId)
+	c.Id = s.Id
+	c.Logo = legacyUrl(s.Logo, c.Logo)
+	c.BackgroundColor = legacyColor(s.BackgrondColor, c.BackgroundColor)
+	c.IsUserAuto = legacyFlag(s.UserAuto, c.IsUserAuto)
+	c.IsOtpRegister = legacyFlag(s.OtpRegister, c.IsOtpRegister)
+	c.IsAutoWithdraw = legacyFlag(s.AutoWithdraw, c.IsAutoWithdraw)
+	c.IsTranWithdraw = legacyFlag(s.TranWithdraw, c.IsTranWithdraw)
+	c.IsRegisterEnabled = legacyFlag(s.Register, c.IsRegisterEnabled)
+	c.MinDepositFirst = legacyAmount(s.DepositFirst, c.MinDepositFirst)
+	c.MinDepositNext = legacyAmount(s.DepositNext, c.MinDepositNext)
+	c.MinWithdraw = legacyAmount(s.Withdraw, c.MinWithdraw)
+	c.Line = legacyText(s.Line, c.Line)
+	c.Url = legacyUrl(s.Url, c.Url)
+	c.Opt = legacyText(s.Opt, c.Opt)
+	c.CreatedAt = s.CreatedAt
+	c.UpdatedAt = s.UpdatedAt
+	return c
+}
+
+// Legacy renders the config in the Settingweb layout, with flags as ACTIVE or DEACTIVE.
+func (c SettingwebConfig) Legacy() Settingweb {
+	return Settingweb{
+		Id:             c.Id,
+		TenantId:       c.TenantId,
+		Logo:           c.Logo,
+		BackgrondColor: c.BackgroundColor,
+		UserAuto:       legacyStatus(c.IsUserAuto),
+		OtpRegister:    legacyStatus(c.IsOtpRegister),
+		AutoWithdraw:   legacyStatus(c.IsAutoWithdraw),
+		TranWithdraw:   legacyStatus(c.IsTranWithdraw),
+		Register:       legacyStatus(c.IsRegisterEnabled),
+		DepositFirst:   strconv.FormatFloat(float64(c.MinDepositFirst), 'f', -1, 32),
+		DepositNext:    strconv.FormatFloat(float64(c.MinDepositNext), 'f', -1, 32),
+		Withdraw:       strconv.FormatFloat(float64(c.MinWithdraw), 'f', -1, 32),
+		Line:           c.Line,
+		Url:            c.Url,
+		Opt:            c.Opt,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
+
+func legacyFlag(value string, fallback bool) bool {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "ACTIVE", "TRUE", "1", "ON", "YES":
+		return true
+	case "DEACTIVE", "INACTIVE", "FALSE", "0", "OFF", "NO":
+		return false
+	}
+	return fallback
+}
+
+func legacyStatus(value bool) string {
+	if value {
+		return "ACTIVE"
+	}
+	return "DEACTIVE"
+}
+
+func legacyAmount(value string, fallback float32) float32 {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 32)
+	if err != nil || amount < 0 {
+		return fallback
+	}
+	return float32(amount)
+}
+
+// legacyColor accepts what the hexcolor validator accepts: #rgb, #rgba, #rrggbb and #rrggbbaa.
+func legacyColor(value string, fallback string) string {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "#") {
+		return fallback
+	}
+	switch len(value) {
+	case 4, 5, 7, 9:
+	default:
+		return fallback
+	}
+	for _, r := range value[1:] {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F') {
+			return fallback
+		}
+	}
+	return value
+}
+
+// legacyUrl keeps absolute urls with a scheme and a host.
+func legacyUrl(value string, fallback string) string {
+	value = strings.TrimSpace(value)
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fallback
+	}
+	return value
+}
+
+func legacyText(value string, fallback string) string {
+	if utf8.RuneCountInString(value) > 255 {
+		return fallback
+	}
+	return value
+}
+
+type SettingwebConfigUpdateBody struct {
+	Logo              *string  `json:"logo" validate:"omitempty,url"`
+	BackgroundColor   *string  `json:"backgroundColor" validate:"omitempty,hexcolor" example:"#1a1a1a"`
+	IsUserAuto        *bool    `json:"isUserAuto"`
+	IsOtpRegister     *bool    `json:"isOtpRegister"`
+	IsAutoWithdraw    *bool    `json:"isAutoWithdraw"`
+	IsTranWithdraw    *bool    `json:"isTranWithdraw"`
+	IsRegisterEnabled *bool    `json:"isRegisterEnabled"`
+	MinDepositFirst   *float32 `json:"minDepositFirst" validate:"omitempty,min=0,max=1000000"`
+	MinDepositNext    *float32 `json:"minDepositNext" validate:"omitempty,min=0,max=1000000"`
+	MinWithdraw       *float32 `json:"minWithdraw" validate:"omitempty,min=0,max=1000000"`
+	Line              *string  `json:"line" validate:"omitempty,max=255"`
+	Url               *string  `json:"url" validate:"omitempty,url"`
+	Opt               *string  `json:"opt" validate:"omitempty,max=255"`
+	Version           int64    `json:"version" validate:"required"`
+	UpdatedByUsername string   `json:"-"`
+}
+
+type SettingwebVersion struct {
+	Id                int64     `json:"id"`
+	TenantId          int64     `json:"tenantId"`
+	SettingwebId      int64     `json:"settingwebId"`
+	Version           int64     `json:"version"`
+	JsonData          string    `json:"jsonData"`
+	JsonChanges       string    `json:"jsonChanges"`
+	Remark            string    `json:"remark"`
+	CreatedByUsername string    `json:"createdByUsername"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type SettingwebVersionListRequest struct {
+	SettingwebId int64  `form:"settingwebId"`
+	Page         int    `form:"page" default:"1" min:"1"`
+	Limit        int    `form:"limit" default:"10" min:"1" max:"100"`
+	SortCol      string `form:"sortCol"`
+	SortAsc      string `form:"sortAsc"`
+}
+
+type SettingwebVersionResponse struct {
+	Id                int64          `json:"id"`
+	SettingwebId      int64          `json:"settingwebId"`
+	Version           int64          `json:"version"`
+	Remark            string         `json:"remark"`
+	CreatedByUsername string         `json:"createdByUsername"`
+	JsonChanges       string         `json:"-"`
+	Changes           *[]FieldChange `json:"changes" gorm:"-"`
+	CreatedAt         time.Time      `json:"createdAt"`
+}
+
+type SettingwebVersionDiffRequest struct {
+	SettingwebId int64 `form:"settingwebId" validate:"required"`
+	FromVersion  int64 `form:"fromVersion" validate:"required"`
+	ToVersion    int64 `form:"toVersion" validate:"required"`
+}
+
+type SettingwebRollbackBody struct {
+	Version int64 `json:"version" validate:"required"`
+	// CurrentVersion is the version the caller last read. The rollback fails when the config has moved on
+	// since, the same as SettingwebConfigUpdateBody.Version.
+	CurrentVersion    int64  `json:"currentVersion" validate:"required"`
+	Remark            string `json:"remark" validate:"max=255"`
+	CreatedByUsername string `json:"-"`
+}
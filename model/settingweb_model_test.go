@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSettingwebConfigFromLegacy(t *testing.T) {
+	c := SettingwebConfigFromLegacy(Settingweb{
+		Id:             4,
+		TenantId:       1,
+		Logo:           "https://cdn.example.com/logo.png",
+		BackgrondColor: "#1A1a1a",
+		Register:       "DEACTIVE",
+		UserAuto:       "on",
+		DepositFirst:   "200",
+		Withdraw:       "abc",
+		Url:            "https://example.com",
+		Line:           "@line",
+	})
+	if c.Id != 4 || c.TenantId != 1 || c.Logo != "https://cdn.example.com/logo.png" || c.BackgroundColor != "#1A1a1a" ||
+		c.IsRegisterEnabled || !c.IsUserAuto || c.MinDepositFirst != 200 || c.MinWithdraw != 100 ||
+		c.Url != "https://example.com" || c.Line != "@line" {
+		t.Fatalf("config = %+v", c)
+	}
+}
+
+func TestSettingwebConfigFromLegacyInvalidValues(t *testing.T) {
+	defaults := NewSettingwebConfig(1)
+	for _, color := range []string{"red", "#12", "#12345", "#gggggg", "000000", ""} {
+		c := SettingwebConfigFromLegacy(Settingweb{TenantId: 1, BackgrondColor: color})
+		if c.BackgroundColor != defaults.BackgroundColor {
+			t.Errorf("color %q = %q", color, c.BackgroundColor)
+		}
+	}
+	for _, color := range []string{"#abc", "#abcd", "#aabbcc", "#aabbccdd"} {
+		if c := SettingwebConfigFromLegacy(Settingweb{BackgrondColor: color}); c.BackgroundColor != color {
+			t.Errorf("color %q = %q", color, c.BackgroundColor)
+		}
+	}
+	for _, u := range []string{"logo.png", "example.com", "/img/logo.png", "http://", "::"} {
+		c := SettingwebConfigFromLegacy(Settingweb{Logo: u, Url: u})
+		if c.Logo != defaults.Logo || c.Url != defaults.Url {
+			t.Errorf("url %q = %q, %q", u, c.Logo, c.Url)
+		}
+	}
+	c := SettingwebConfigFromLegacy(Settingweb{Opt: strings.Repeat("x", 256)})
+	if c.Opt != defaults.Opt {
+		t.Errorf("opt kept %d characters", len(c.Opt))
+	}
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Tenant struct {
+	Id        int64          `json:"id"`
+	Code      string         `json:"code"`
+	Name      string         `json:"name"`
+	Domain    string         `json:"domain"`
+	Status    string         `json:"status"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt *time.Time     `json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `json:"deletedAt"`
+}
+
+type TenantCreateBody struct {
+	Code   string `json:"code" validate:"required,max=20"`
+	Name   string `json:"name" validate:"required,max=255"`
+	Domain string `json:"domain" validate:"required,fqdn" example:"brand.example.com"`
+	Status string `json:"status" validate:"required" enums:"ACTIVE,DEACTIVE" default:"ACTIVE"`
+}
+
+type TenantUpdateBody struct {
+	Name   *string `json:"name" validate:"omitempty,max=255"`
+	Domain *string `json:"domain" validate:"omitempty,fqdn"`
+	Status *string `json:"status" enums:"ACTIVE,DEACTIVE"`
+}
+
+type TenantListRequest struct {
+	Status  string `form:"status"`
+	Page    int    `form:"page" default:"1" min:"1"`
+	Limit   int    `form:"limit" default:"10" min:"1" max:"100"`
+	Search  string `form:"search"`
+	SortCol string `form:"sortCol"`
+	SortAsc string `form:"sortAsc"`
+}
+
+// AdminTenant is the source of truth for which tenants an admin may act in and with which group.
+// Admin itself is global; Admin.AdminGroupId mirrors the group of the IsDefault row for older callers.
+// The table is read across tenants to list and switch them, so the tenant column is MemberTenantId
+// rather than TenantId, which the tenant plugin would scope to the current tenant.
+type AdminTenant struct {
+	Id             int64     `json:"id"`
+	AdminId        int64     `json:"adminId"`
+	MemberTenantId int64     `json:"tenantId"`
+	AdminGroupId   int64     `json:"adminGroupId"`
+	IsDefault      bool      `json:"isDefault"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type AdminTenantBody struct {
+	TenantId     int64 `json:"tenantId" validate:"required"`
+	AdminGroupId int64 `json:"adminGroupId" validate:"required"`
+	IsDefault    bool  `json:"isDefault" default:"false"`
+}
+
+type AdminTenantList struct {
+	TenantId     int64  `json:"tenantId"`
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	AdminGroupId int64  `json:"adminGroupId"`
+	IsDefault    bool   `json:"isDefault"`
+}
+
+type TenantSwitchBody struct {
+	TenantId int64 `json:"tenantId" validate:"required"`
+}
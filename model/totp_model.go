@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+)
+
+type AdminTotp struct {
+	Id           int64      `json:"id"`
+	AdminId      int64      `json:"adminId"`
+	Secret       string     `json:"-"`
+	IsEnabled    bool       `json:"isEnabled"`
+	LastUsedStep int64      `json:"-"`
+	ConfirmedAt  *time.Time `json:"confirmedAt"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+}
+
+type AdminRecoveryCode struct {
+	Id        int64      `json:"id"`
+	AdminId   int64      `json:"adminId"`
+	CodeHash  string     `json:"-"`
+	UsedAt    *time.Time `json:"usedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type TotpEnrollResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningUri string `json:"provisioningUri" example:"otpauth://totp/Backoffice:admin01?algorithm=SHA1&digits=6&issuer=Backoffice&period=30&secret=JBSWY3DPEHPK3PXP"`
+}
+
+type TotpVerifyBody struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type TotpConfirmResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type TotpDisableBody struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+type TotpStatusResponse struct {
+	AdminId           int64      `json:"adminId"`
+	IsEnabled         bool       `json:"isEnabled"`
+	IsRequired        bool       `json:"isRequired"`
+	ConfirmedAt       *time.Time `json:"confirmedAt"`
+	RecoveryCodesLeft int64      `json:"recoveryCodesLeft"`
+}
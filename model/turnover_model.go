@@ -0,0 +1,146 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TurnoverRequirement struct {
+	Id                 int64          `json:"id"`
+	TenantId           int64          `json:"tenantId"`
+	UserId             int64          `json:"userId"`
+	MemberCode         string         `json:"memberCode"`
+	SourceType         string         `json:"sourceType"`
+	TransactionId      *int64         `json:"transactionId"`
+	PromotionClaimId   *int64         `json:"promotionClaimId"`
+	BaseAmount         float32        `json:"baseAmount" sql:"type:decimal(14,2);"`
+	Multiplier         float32        `json:"multiplier" sql:"type:decimal(8,2);"`
+	RequiredAmount     float32        `json:"requiredAmount" sql:"type:decimal(14,2);"`
+	AccumulatedAmount  float32        `json:"accumulatedAmount" sql:"type:decimal(14,2);"`
+	Status             string         `json:"status"`
+	ExpiredAt          *time.Time     `json:"expiredAt"`
+	CompletedAt        *time.Time     `json:"completedAt"`
+	CanceledAt         *time.Time     `json:"canceledAt"`
+	CanceledByUserId   int64          `json:"canceledByUserId"`
+	CanceledByUsername string         `json:"canceledByUsername"`
+	CancelRemark       string         `json:"cancelRemark"`
+	CreatedAt          time.Time      `json:"createdAt"`
+	UpdatedAt          *time.Time     `json:"updatedAt"`
+	DeletedAt          gorm.DeletedAt `json:"deletedAt"`
+}
+
+type TurnoverRequirementCreateBody struct {
+	UserId           int64      `json:"userId" validate:"required"`
+	SourceType       string     `json:"sourceType" validate:"required" enums:"BONUS,PROMOTION,DEPOSIT,MANUAL" example:"PROMOTION"`
+	TransactionId    *int64     `json:"transactionId"`
+	PromotionClaimId *int64     `json:"promotionClaimId"`
+	BaseAmount       float32    `json:"baseAmount" validate:"required,gt=0"`
+	Multiplier       float32    `json:"multiplier" validate:"required,gt=0"`
+	ExpiredAt        *time.Time `json:"expiredAt" example:"2023-05-31T22:33:44+07:00"`
+}
+
+type TurnoverRequirementCancelBody struct {
+	Status             string    `json:"-"`
+	CancelRemark       string    `json:"cancelRemark" validate:"required"`
+	CanceledAt         time.Time `json:"-"`
+	CanceledByUserId   int64     `json:"-"`
+	CanceledByUsername string    `json:"-"`
+}
+
+type TurnoverRequirementListRequest struct {
+	UserId     string `form:"userId" extensions:"x-order:1"`
+	SourceType string `form:"sourceType" extensions:"x-order:2"`
+	Status     string `form:"status" extensions:"x-order:3" enums:"ACTIVE,COMPLETED,EXPIRED,CANCELED"`
+	Search     string `form:"search" extensions:"x-order:4"`
+	Page       int    `form:"page" extensions:"x-order:5" default:"1" min:"1"`
+	Limit      int    `form:"limit" extensions:"x-order:6" default:"10" min:"1" max:"100"`
+	SortCol    string `form:"sortCol" extensions:"x-order:7"`
+	SortAsc    string `form:"sortAsc" extensions:"x-order:8"`
+}
+
+type TurnoverRequirementResponse struct {
+	Id                int64      `json:"id"`
+	UserId            int64      `json:"userId"`
+	MemberCode        string     `json:"memberCode"`
+	SourceType        string     `json:"sourceType"`
+	TransactionId     *int64     `json:"transactionId"`
+	PromotionClaimId  *int64     `json:"promotionClaimId"`
+	PromotionName     string     `json:"promotionName"`
+	BaseAmount        float32    `json:"baseAmount"`
+	Multiplier        float32    `json:"multiplier"`
+	RequiredAmount    float32    `json:"requiredAmount"`
+	AccumulatedAmount float32    `json:"accumulatedAmount"`
+	RemainingAmount   float32    `json:"remainingAmount"`
+	ProgressPercent   float32    `json:"progressPercent"`
+	Status            string     `json:"status"`
+	ExpiredAt         *time.Time `json:"expiredAt"`
+	CompletedAt       *time.Time `json:"completedAt"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
+
+type TurnoverBet struct {
+	Id            int64     `json:"id"`
+	TenantId      int64     `json:"tenantId"`
+	UserId        int64     `json:"userId"`
+	MemberCode    string    `json:"memberCode"`
+	Provider      string    `json:"provider"`
+	GameCode      string    `json:"gameCode"`
+	RefId         string    `json:"refId"`
+	BetAmount     float32   `json:"betAmount" sql:"type:decimal(14,2);"`
+	ValidAmount   float32   `json:"validAmount" sql:"type:decimal(14,2);"`
+	WinLoseAmount float32   `json:"winLoseAmount" sql:"type:decimal(14,2);"`
+	BetAt         time.Time `json:"betAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type TurnoverBetCreateBody struct {
+	MemberCode    string    `json:"memberCode" validate:"required"`
+	UserId        int64     `json:"-"`
+	Provider      string    `json:"provider" validate:"required"`
+	GameCode      string    `json:"gameCode"`
+	RefId         string    `json:"refId" validate:"required"`
+	BetAmount     float32   `json:"betAmount" validate:"required,gt=0"`
+	ValidAmount   float32   `json:"validAmount" validate:"min=0"`
+	WinLoseAmount float32   `json:"winLoseAmount"`
+	BetAt         time.Time `json:"betAt" validate:"required" example:"2023-05-31T22:33:44+07:00"`
+}
+
+type TurnoverSummary struct {
+	UserId            int64                         `json:"userId"`
+	MemberCode        string                        `json:"memberCode"`
+	TurnoverLimit     int                           `json:"turnoverLimit"`
+	RequiredAmount    float32                       `json:"requiredAmount"`
+	AccumulatedAmount float32                       `json:"accumulatedAmount"`
+	RemainingAmount   float32                       `json:"remainingAmount"`
+	CanWithdraw       bool                          `json:"canWithdraw"`
+	Requirements      []TurnoverRequirementResponse `json:"requirements"`
+}
+
+type TurnoverWithdrawCheck struct {
+	UserId          int64   `json:"userId"`
+	CanWithdraw     bool    `json:"canWithdraw"`
+	Action          string  `json:"action" enums:"ALLOW,FLAG,BLOCK"`
+	RemainingAmount float32 `json:"remainingAmount"`
+	ActiveCount     int64   `json:"activeCount"`
+}
+
+// RemainingAmount returns how much turnover is still needed to complete the requirement.
+func (r TurnoverRequirement) RemainingAmount() float32 {
+	if r.Status != "ACTIVE" || r.AccumulatedAmount >= r.RequiredAmount {
+		return 0
+	}
+	return r.RequiredAmount - r.AccumulatedAmount
+}
+
+// OutstandingTurnover sums the remaining amount of the active requirements, rounded up.
+// It is the value kept in User.TurnoverLimit and Member.TurnoverLimit, so both must be
+// recalculated whenever a requirement is created, accumulated, completed or canceled.
+func OutstandingTurnover(reqs []TurnoverRequirement) int {
+	var total float64
+	for _, r := range reqs {
+		total += float64(r.RemainingAmount())
+	}
+	return int(math.Ceil(total))
+}
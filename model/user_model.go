@@ -7,33 +7,42 @@ import (
 )
 
 type User struct {
-	Id            int64          `json:"id"`
-	Partner       *string        `json:"partner"`
-	MemberCode    *string        `json:"memberCode"`
-	Username      string         `json:"username"`
-	Phone         string         `json:"phone"`
-	Promotion     *string        `json:"promotion"`
-	Password      string         `json:"password"`
-	Status        string         `json:"status"`
-	Firstname     string         `json:"firstname"`
-	Lastname      string         `json:"lastname"`
-	Fullname      string         `json:"fullname"`
-	Bankname      string         `json:"bankname"`
-	BankCode      string         `json:"bankCode"`
-	BankAccount   string         `json:"bankAccount"`
-	Channel       string         `json:"channel"`
-	TrueWallet    string         `json:"trueWallet"`
-	Contact       string         `json:"contact"`
-	Note          string         `json:"note"`
-	Course        string         `json:"course"`
-	Credit        float64        `json:"credit"`
-	TurnoverLimit int            `json:"turnoverLimit"`
-	Ip            string         `json:"ip"`
-	IpRegistered  string         `json:"ipRegistered"`
-	CreatedAt     time.Time      `json:"createdAt"`
-	UpdatedAt     time.Time      `json:"updatedAt"`
-	DeletedAt     gorm.DeletedAt `json:"deletedAt"`
-	LogedinAt     *time.Time     `json:"logedinAt" gorm:"default:CURRENT_TIMESTAMP"`
+	Id                int64          `json:"id"`
+	TenantId          int64          `json:"tenantId"`
+	Partner           *string        `json:"partner"`
+	MemberCode        *string        `json:"memberCode"`
+	Username          string         `json:"username"`
+	Phone             string         `json:"phone"`
+	Promotion         *string        `json:"promotion"`
+	Tier              string         `json:"tier"`
+	Password          string         `json:"-"`
+	PasswordUpdatedAt *time.Time     `json:"passwordUpdatedAt"`
+	Status            string         `json:"status"`
+	Firstname         string         `json:"firstname"`
+	Lastname          string         `json:"lastname"`
+	Fullname          string         `json:"fullname"`
+	Bankname          string         `json:"bankname"`
+	BankCode          string         `json:"bankCode"`
+	BankAccount       string         `json:"bankAccount"`
+	Channel           string         `json:"channel"`
+	RecommendId       *int64         `json:"recommendId"`
+	TrueWallet        string         `json:"trueWallet"`
+	Contact           string         `json:"contact"`
+	Note              string         `json:"note"`
+	Course            string         `json:"course"`
+	Credit            float64        `json:"credit"`
+	TurnoverLimit     int            `json:"turnoverLimit"`
+	Ip                string         `json:"ip"`
+	IpRegistered      string         `json:"ipRegistered"`
+	DeviceId          string         `json:"deviceId"`
+	AccountToName     string         `json:"accountToName"`
+	NameVerifyStatus  string         `json:"nameVerifyStatus"`
+	NameMatchScore    float32        `json:"nameMatchScore"`
+	NameVerifiedAt    *time.Time     `json:"nameVerifiedAt"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	UpdatedAt         time.Time      `json:"updatedAt"`
+	DeletedAt         gorm.DeletedAt `json:"deletedAt"`
+	LogedinAt         *time.Time     `json:"logedinAt" gorm:"default:CURRENT_TIMESTAMP"`
 }
 
 type CreateUser struct {
@@ -41,22 +50,24 @@ type CreateUser struct {
 	MemberCode   string `json:"memberCode" validate:"max=255" default:""`
 	Phone        string `json:"phone" validate:"required,min=10,max=12" example:"0812345678"`
 	Promotion    string `json:"promotion" validate:"max=20"  default:""`
-	Password     string `json:"password" validate:"required,min=8,max=255"`
+	Password     string `json:"password" validate:"required,max=128"`
 	Fullname     string `json:"fullname" validate:"required,max=255"`
 	Bankname     string `json:"bankname" validate:"required,max=50"`
 	BankCode     string `json:"bankCode" validate:"required,max=10"`
 	BankAccount  string `json:"bankAccount" validate:"required,max=15"`
 	Channel      string `json:"channel" validate:"required,max=20" enum:"Google,Youtube,Facebook" example:"Google"`
+	RecommendId  *int64 `json:"recommendId"`
 	TrueWallet   string `json:"trueWallet" validate:"required,max=20"`
 	Contact      string `json:"contact" validate:"max=255"`
 	Note         string `json:"note" validate:"max=255"`
 	Course       string `json:"course" validate:"max=50"`
 	IpRegistered string `json:"ipRegistered" validate:"required,max=20" example:"1.1.1.1"`
+	DeviceId     string `json:"deviceId" validate:"max=255"`
 }
 
 type LoginUser struct {
 	Username string `json:"username" validate:"required,min=8,max=30"`
-	Password string `json:"password" validate:"required,8,max=30"`
+	Password string `json:"password" validate:"required,max=128"`
 	IP       string `json:"ip"`
 }
 
@@ -78,6 +89,7 @@ type UpdateUser struct {
 	Partner     string `json:"partner" validate:"max=20"`
 	MemberCode  string `json:"memberCode" validate:"max=255"`
 	Promotion   string `json:"promotion" validate:"max=20"`
+	Tier        string `json:"tier" validate:"max=20"`
 	Bankname    string `json:"bankname" validate:"max=50"`
 	BankCode    string `json:"bankCode" validate:"max=10"`
 	BankAccount string `json:"bankAccount" validate:"max=15"`
@@ -115,23 +127,31 @@ type UserList struct {
 }
 
 type UserDetail struct {
-	Id          int64  `json:"id"`
-	Partner     string `json:"partner"`
-	MemberCode  string `json:"memberCode"`
-	Phone       string `json:"phone"`
-	Promotion   string `json:"promotion"`
-	Fullname    string `json:"fullname"`
-	Bankname    string `json:"bankname"`
-	BankAccount string `json:"bankAccount"`
-	Channel     string `json:"channel"`
-	TrueWallet  string `json:"trueWallet"`
-	Contact     string `json:"contact"`
-	Note        string `json:"note"`
-	Course      string `json:"course"`
+	Id               int64                  `json:"id"`
+	Partner          string                 `json:"partner"`
+	MemberCode       string                 `json:"memberCode"`
+	Phone            string                 `json:"phone"`
+	Promotion        string                 `json:"promotion"`
+	Tier             string                 `json:"tier"`
+	Fullname         string                 `json:"fullname"`
+	Bankname         string                 `json:"bankname"`
+	BankAccount      string                 `json:"bankAccount"`
+	Channel          string                 `json:"channel"`
+	RecommendId      *int64                 `json:"recommendId"`
+	TrueWallet       string                 `json:"trueWallet"`
+	Contact          string                 `json:"contact"`
+	Note             string                 `json:"note"`
+	Course           string                 `json:"course"`
+	AccountToName    string                 `json:"accountToName"`
+	NameVerifyStatus string                 `json:"nameVerifyStatus"`
+	NameMatchScore   float32                `json:"nameMatchScore"`
+	RiskScore        float32                `json:"riskScore"`
+	LinkedAccounts   *[]MemberLinkedAccount `json:"linkedAccounts"`
 }
 
 type UserUpdatePassword struct {
-	Password string `json:"password" validate:"required,min=8,max=30"`
+	Password    string `json:"password" validate:"required,max=128"`
+	OldPassword string `json:"oldPassword"`
 }
 
 type UserByPhone struct {
@@ -141,24 +161,31 @@ type UserByPhone struct {
 
 type UserLoginLog struct {
 	Id        int64     `json:"id"`
+	TenantId  int64     `json:"tenantId"`
 	UserId    int64     `json:"userId"`
 	Ip        string    `json:"ip"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
 type UserUpdateLogs struct {
+	TenantId          int64  `json:"tenantId"`
 	UserId            int64  `json:"userId"`
 	Description       string `json:"description"`
+	DescriptionEn     string `json:"descriptionEn"`
+	JsonChanges       string `json:"jsonChanges"`
 	CreatedByUsername string `json:"createdByUsername"`
 	Ip                string `json:"ip"`
 }
 
 type UserUpdateLogResponse struct {
-	UserId            int64      `json:"userId"`
-	Description       string     `json:"description"`
-	CreatedByUsername string     `json:"createdByUsername"`
-	Ip                string     `json:"ip"`
-	CreatedAt         *time.Time `json:"createdAt"`
+	UserId            int64          `json:"userId"`
+	Description       string         `json:"description"`
+	DescriptionEn     string         `json:"descriptionEn"`
+	JsonChanges       string         `json:"-"`
+	Changes           *[]FieldChange `json:"changes" gorm:"-"`
+	CreatedByUsername string         `json:"createdByUsername"`
+	Ip                string         `json:"ip"`
+	CreatedAt         *time.Time     `json:"createdAt"`
 }
 
 type UserUpdateQuery struct {
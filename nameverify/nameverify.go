@@ -0,0 +1,219 @@
+// Package nameverify compares the name a member registered with against the bank account holder name.
+package nameverify
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// Score thresholds: from VerifiedScore the names match, below ReviewScore they do not, and anything in
+// between waits for an admin as PENDING.
+const (
+	VerifiedScore = 85
+	ReviewScore   = 60
+)
+
+// titles are stripped from the start of a name, longest first so นางสาว is not read as นาง + สาว.
+var titles = []string{
+	"เด็กหญิง", "เด็กชาย", "นางสาว", "นาง", "นาย", "น.ส.", "ด.ญ.", "ด.ช.", "คุณ",
+	"mrs.", "mrs", "miss", "mr.", "mr", "ms.", "ms", "dr.", "dr", "khun",
+}
+
+// Normalize strips titles, punctuation and extra spaces and lower-cases Latin letters:
+// "นางสาว  สมหญิง ใจดี" becomes "สมหญิง ใจดี" and "MR. Somchai  Jaidee" becomes "somchai jaidee".
+func Normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for stripped := true; stripped; {
+		stripped = false
+		for _, title := range titles {
+			rest := strings.TrimPrefix(name, title)
+			if rest == name || strings.TrimSpace(rest) == "" {
+				continue
+			}
+			// A Latin title has to end at a word boundary; Thai is written without spaces.
+			if isLatin(title) && !strings.HasSuffix(title, ".") && !startsWithSpace(rest) {
+				continue
+			}
+			name, stripped = strings.TrimSpace(rest), true
+			break
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.Is(unicode.Mn, r):
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '.' || r == '-':
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// thaiConsonants maps each consonant to the Latin consonant it is usually romanised with. Vowels and
+// tone marks carry no consonant and are dropped, as are the silent อ and ห and the glide ย.
+var thaiConsonants = map[rune]string{
+	'ก': "k", 'ข': "k", 'ฃ': "k", 'ค': "k", 'ฅ': "k", 'ฆ': "k", 'ง': "ng",
+	'จ': "c", 'ฉ': "c", 'ช': "c", 'ฌ': "c", 'ซ': "s", 'ศ': "s", 'ษ': "s", 'ส': "s",
+	'ด': "d", 'ฎ': "d", 'ต': "t", 'ฏ': "t", 'ถ': "t", 'ท': "t", 'ธ': "t", 'ฐ': "t", 'ฑ': "t", 'ฒ': "t",
+	'น': "n", 'ณ': "n", 'บ': "b", 'ป': "p", 'ผ': "p", 'พ': "p", 'ภ': "p", 'ฝ': "f", 'ฟ': "f",
+	'ม': "m", 'ร': "r", 'ฤ': "r", 'ล': "l", 'ฬ': "l", 'ว': "w",
+}
+
+// latinDigraphs reduce romanised spellings to the same consonants, e.g. Jaidee, Chaidee and Chaydee.
+var latinDigraphs = strings.NewReplacer(
+	"ph", "p", "th", "t", "kh", "k", "ch", "c", "sh", "s", "ck", "k",
+	"j", "c", "v", "w", "z", "s", "q", "k", "x", "s",
+)
+
+// Phonetic transliterates a normalised Thai or Latin name to a consonant key, so "สมชาย ใจดี" and
+// "somchai jaidee" both become "smc cd". It is deliberately lossy: it is only used to compare names
+// written in different scripts.
+func Phonetic(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		var b strings.Builder
+		if isLatin(word) {
+			word = latinDigraphs.Replace(word)
+			for _, r := range word {
+				switch r {
+				case 'a', 'e', 'i', 'o', 'u', 'y', 'h':
+				default:
+					b.WriteRune(r)
+				}
+			}
+		} else {
+			var consonants []string
+			for _, r := range word {
+				// The thanthakhat ์ silences the consonant before it, e.g. ศักดิ์ is sak.
+				if r == '์' && len(consonants) > 0 {
+					consonants = consonants[:len(consonants)-1]
+					continue
+				}
+				if c, ok := thaiConsonants[r]; ok {
+					consonants = append(consonants, c)
+				}
+			}
+			b.WriteString(strings.Join(consonants, ""))
+		}
+		words[i] = collapse(b.String())
+	}
+	return strings.Join(words, " ")
+}
+
+// Score returns how alike two names are from 0 to 100. Names in the same script are compared letter by
+// letter after Normalize; a Thai name and a Latin one are compared by their Phonetic keys.
+func Score(a, b string) float32 {
+	a, b = Normalize(a), Normalize(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 100
+	}
+	if isLatin(a) != isLatin(b) {
+		a, b = Phonetic(a), Phonetic(b)
+	}
+	return similarity(a, b)
+}
+
+// Status maps a score to the NameVerification status.
+func Status(score float32) string {
+	switch {
+	case score >= VerifiedScore:
+		return "VERIFIED"
+	case score < ReviewScore:
+		return "MISMATCH"
+	}
+	return "PENDING"
+}
+
+// Verify compares the registered name with the holder name returned by CustomerAccountInfo.
+// An empty holder name means the lookup failed and gives ERROR.
+func Verify(req model.NameVerifyRequest, accountToName string) model.NameVerifyResponse {
+	res := model.NameVerifyResponse{
+		UserId:                req.UserId,
+		InputName:             req.Fullname,
+		AccountToName:         accountToName,
+		NormalizedInputName:   Normalize(req.Fullname),
+		NormalizedAccountName: Normalize(accountToName),
+	}
+	if res.NormalizedAccountName == "" {
+		res.Status = "ERROR"
+		return res
+	}
+	res.MatchScore = Score(req.Fullname, accountToName)
+	res.Status = Status(res.MatchScore)
+	return res
+}
+
+func similarity(a, b string) float32 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 100
+	}
+	score := 100 * (1 - float32(levenshtein(ra, rb))/float32(longest))
+	return float32(int(score*100+0.5)) / 100
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func collapse(s string) string {
+	var b strings.Builder
+	var last rune
+	for _, r := range s {
+		if r != last {
+			b.WriteRune(r)
+		}
+		last = r
+	}
+	return b.String()
+}
+
+func isLatin(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Thai, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func startsWithSpace(s string) bool {
+	return s != "" && (s[0] == ' ' || s[0] == '\t')
+}
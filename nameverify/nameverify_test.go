@@ -0,0 +1,74 @@
+package nameverify
+
+import (
+	"testing"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct{ in, want string }{
+		{"นาย สมชาย ใจดี", "สมชาย ใจดี"},
+		{"นายสมชาย  ใจดี", "สมชาย ใจดี"},
+		{"นางสาวสมหญิง ใจดี", "สมหญิง ใจดี"},
+		{"นาง สมศรี มีสุข", "สมศรี มีสุข"},
+		{"น.ส.สมหญิง ใจดี", "สมหญิง ใจดี"},
+		{"  MR. Somchai   JAIDEE ", "somchai jaidee"},
+		{"Mrs Somsri Meesuk", "somsri meesuk"},
+		{"Mrinal Sen", "mrinal sen"},
+		{"Somchai Jai-dee", "somchai jai dee"},
+		{"นาย", "นาย"},
+	}
+	for _, c := range cases {
+		if got := Normalize(c.in); got != c.want {
+			t.Errorf("Normalize(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestPhonetic(t *testing.T) {
+	cases := []struct{ in, want string }{
+		{"สมชาย ใจดี", "smc cd"},
+		{"somchai jaidee", "smc cd"},
+		{"สมศักดิ์ ทองดี", "smsk tngd"},
+		{"somsak thongdee", "smsk tngd"},
+		{"พิชัย", "pc"},
+		{"phichai", "pc"},
+	}
+	for _, c := range cases {
+		if got := Phonetic(c.in); got != c.want {
+			t.Errorf("Phonetic(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	cases := []struct {
+		input, account string
+		status         string
+	}{
+		{"สมชาย ใจดี", "นาย สมชาย ใจดี", "VERIFIED"},
+		{"Somchai Jaidee", "MR SOMCHAI JAIDEE", "VERIFIED"},
+		{"สมชาย ใจดี", "MR. SOMCHAI JAIDEE", "VERIFIED"},
+		{"สมศักดิ์ ทองดี", "SOMSAK THONGDEE", "VERIFIED"},
+		{"สมชาย ใจดีมาก", "นาย สมชาย ใจดี", "PENDING"},
+		{"สมชาย ใจดี", "นางสาว วิภา รักไทย", "MISMATCH"},
+		{"Somchai Jaidee", "WIPA RAKTHAI", "MISMATCH"},
+	}
+	for _, c := range cases {
+		score := Score(c.input, c.account)
+		if got := Status(score); got != c.status {
+			t.Errorf("Score(%q, %q) = %.2f (%s), want %s", c.input, c.account, score, got, c.status)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	res := Verify(model.NameVerifyRequest{UserId: 5, Fullname: "สมชาย ใจดี"}, "นาย สมชาย ใจดี")
+	if res.Status != "VERIFIED" || res.MatchScore != 100 || res.NormalizedAccountName != "สมชาย ใจดี" || res.UserId != 5 {
+		t.Fatalf("unexpected response %+v", res)
+	}
+	if res := Verify(model.NameVerifyRequest{UserId: 5, Fullname: "สมชาย ใจดี"}, ""); res.Status != "ERROR" {
+		t.Fatalf("expected ERROR without a holder name, got %+v", res)
+	}
+}
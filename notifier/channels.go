@@ -0,0 +1,129 @@
+package notifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+const (
+	LineBaseUrl     = "https://api.line.me"
+	TelegramBaseUrl = "https://api.telegram.org"
+)
+
+// LineMessaging pushes to a user, group or room id through the LINE Messaging API.
+type LineMessaging struct {
+	Token   string
+	To      string
+	BaseUrl string
+	Client  *http.Client
+}
+
+func (n *LineMessaging) Send(ctx context.Context, msg model.NotifyMessage) error {
+	messages := []map[string]string{{"type": "text", "text": text(msg)}}
+	if msg.ImageUrl != "" {
+		messages = append(messages, map[string]string{"type": "image", "originalContentUrl": msg.ImageUrl, "previewImageUrl": msg.ImageUrl})
+	}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+n.Token)
+	return postJSON(ctx, n.Client, baseUrl(n.BaseUrl, LineBaseUrl)+"/v2/bot/message/push", map[string]interface{}{
+		"to":       n.To,
+		"messages": messages,
+	}, header)
+}
+
+// Telegram sends through the Bot API. ChatId is the chat or channel id, e.g. -1001234567890.
+type Telegram struct {
+	Token   string
+	ChatId  string
+	BaseUrl string
+	Client  *http.Client
+}
+
+func (n *Telegram) Send(ctx context.Context, msg model.NotifyMessage) error {
+	url := baseUrl(n.BaseUrl, TelegramBaseUrl) + "/bot" + n.Token
+	if msg.ImageUrl != "" {
+		return postJSON(ctx, n.Client, url+"/sendPhoto", map[string]string{
+			"chat_id": n.ChatId,
+			"photo":   msg.ImageUrl,
+			"caption": text(msg),
+		}, nil)
+	}
+	return postJSON(ctx, n.Client, url+"/sendMessage", map[string]string{
+		"chat_id": n.ChatId,
+		"text":    text(msg),
+	}, nil)
+}
+
+type Discord struct {
+	WebhookUrl string
+	Client     *http.Client
+}
+
+func (n *Discord) Send(ctx context.Context, msg model.NotifyMessage) error {
+	payload := map[string]interface{}{"content": text(msg)}
+	if msg.ImageUrl != "" {
+		payload["embeds"] = []map[string]interface{}{{"image": map[string]string{"url": msg.ImageUrl}}}
+	}
+	return postJSON(ctx, n.Client, n.WebhookUrl, payload, nil)
+}
+
+type Slack struct {
+	WebhookUrl string
+	Client     *http.Client
+}
+
+func (n *Slack) Send(ctx context.Context, msg model.NotifyMessage) error {
+	return postJSON(ctx, n.Client, n.WebhookUrl, map[string]string{"text": text(msg)}, nil)
+}
+
+// Webhook posts the NotifyMessage as JSON. When Secret is set the request carries
+// X-Timestamp and X-Signature: sha256=hex(hmac(secret, timestamp + "." + body)).
+type Webhook struct {
+	Url    string
+	Secret string
+	Client *http.Client
+	Now    func() time.Time
+}
+
+func (n *Webhook) Send(ctx context.Context, msg model.NotifyMessage) error {
+	header := http.Header{}
+	if n.Secret != "" {
+		body, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		now := time.Now
+		if n.Now != nil {
+			now = n.Now
+		}
+		timestamp := strconv.FormatInt(now().Unix(), 10)
+		header.Set("X-Timestamp", timestamp)
+		header.Set("X-Signature", "sha256="+Sign(n.Secret, timestamp, body))
+	}
+	return postJSON(ctx, n.Client, n.Url, msg, header)
+}
+
+// Sign returns the hex HMAC-SHA256 that receivers of a Webhook channel should compare against.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func baseUrl(url, fallback string) string {
+	if url == "" {
+		return fallback
+	}
+	return strings.TrimRight(url, "/")
+}
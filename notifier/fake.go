@@ -0,0 +1,37 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// Fake records messages in memory instead of sending them. Set Err to simulate a failing channel.
+type Fake struct {
+	mu       sync.Mutex
+	messages []model.NotifyMessage
+	Err      error
+}
+
+func (n *Fake) Send(ctx context.Context, msg model.NotifyMessage) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.Err != nil {
+		return n.Err
+	}
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+func (n *Fake) Messages() []model.NotifyMessage {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]model.NotifyMessage(nil), n.messages...)
+}
+
+func (n *Fake) Reset() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.messages = nil
+}
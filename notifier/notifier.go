@@ -0,0 +1,99 @@
+// Package notifier sends NotifyMessage values to the channel types stored in NotifyChannel.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+var ErrUnsupportedChannel = errors.New("notifier: unsupported channel type")
+
+type Notifier interface {
+	Send(ctx context.Context, msg model.NotifyMessage) error
+}
+
+// HTTPError is returned when a provider answers with a non 2xx status.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("notifier: unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// New builds the notifier for a stored channel. client may be nil to use a client with a 10 second timeout.
+func New(channel model.NotifyChannel, client *http.Client) (Notifier, error) {
+	switch channel.ChannelType {
+	case "LINE_MESSAGING":
+		return &LineMessaging{Token: channel.Token, To: channel.Target, Client: client}, nil
+	case "TELEGRAM":
+		return &Telegram{Token: channel.Token, ChatId: channel.Target, Client: client}, nil
+	case "DISCORD":
+		return &Discord{WebhookUrl: channel.WebhookUrl, Client: client}, nil
+	case "SLACK":
+		return &Slack{WebhookUrl: channel.WebhookUrl, Client: client}, nil
+	case "WEBHOOK":
+		return &Webhook{Url: channel.WebhookUrl, Secret: channel.Secret, Client: client}, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedChannel, channel.ChannelType)
+}
+
+func text(msg model.NotifyMessage) string {
+	if msg.Title == "" {
+		return msg.Message
+	}
+	return msg.Title + "\n" + msg.Message
+}
+
+// postJSON never returns the endpoint in its errors: Telegram puts the bot token in the path and Discord
+// and Slack webhook urls are secrets, while errors end up in NotifyQueue.LastError.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, payload interface{}, header http.Header) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("notifier: invalid url: %w", withoutUrl(err))
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if client == nil {
+		client = defaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notifier: request failed: %w", withoutUrl(err))
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return &HTTPError{StatusCode: res.StatusCode, Body: string(b)}
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return nil
+}
+
+// withoutUrl drops the url that net/url and net/http errors repeat in their message.
+func withoutUrl(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+type capturedRequest struct {
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+func newTestServer(t *testing.T, status int) (*httptest.Server, *[]capturedRequest) {
+	var captured []capturedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		captured = append(captured, capturedRequest{Path: r.URL.Path, Header: r.Header, Body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &captured
+}
+
+func decode(t *testing.T, b []byte) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestLineMessagingPush(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusOK)
+	n := &LineMessaging{Token: "line-token", To: "U123", BaseUrl: server.URL}
+	if err := n.Send(context.Background(), model.NotifyMessage{Title: "Deposit", Message: "100.00"}); err != nil {
+		t.Fatal(err)
+	}
+	req := (*captured)[0]
+	if req.Path != "/v2/bot/message/push" || req.Header.Get("Authorization") != "Bearer line-token" {
+		t.Fatalf("unexpected request %s %v", req.Path, req.Header)
+	}
+	body := decode(t, req.Body)
+	text := body["messages"].([]interface{})[0].(map[string]interface{})["text"]
+	if body["to"] != "U123" || text != "Deposit\n100.00" {
+		t.Fatalf("unexpected body %s", req.Body)
+	}
+}
+
+func TestTelegramSendMessage(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusOK)
+	n := &Telegram{Token: "bot-token", ChatId: "-100", BaseUrl: server.URL}
+	if err := n.Send(context.Background(), model.NotifyMessage{Message: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	req := (*captured)[0]
+	body := decode(t, req.Body)
+	if req.Path != "/botbot-token/sendMessage" || body["chat_id"] != "-100" || body["text"] != "hello" {
+		t.Fatalf("unexpected request %s %s", req.Path, req.Body)
+	}
+}
+
+func TestDiscordAndSlackPayloads(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusNoContent)
+	msg := model.NotifyMessage{Message: "hello"}
+	if err := (&Discord{WebhookUrl: server.URL + "/discord"}).Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Slack{WebhookUrl: server.URL + "/slack"}).Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	if decode(t, (*captured)[0].Body)["content"] != "hello" || decode(t, (*captured)[1].Body)["text"] != "hello" {
+		t.Fatalf("unexpected payloads %s %s", (*captured)[0].Body, (*captured)[1].Body)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusOK)
+	now := time.Unix(1685547224, 0)
+	n := &Webhook{Url: server.URL, Secret: "s3cret", Now: func() time.Time { return now }}
+	if err := n.Send(context.Background(), model.NotifyMessage{ChannelId: 1, Message: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	req := (*captured)[0]
+	if req.Header.Get("X-Timestamp") != "1685547224" {
+		t.Fatalf("unexpected timestamp %q", req.Header.Get("X-Timestamp"))
+	}
+	if want := "sha256=" + Sign("s3cret", "1685547224", req.Body); req.Header.Get("X-Signature") != want {
+		t.Fatalf("signature %q, want %q", req.Header.Get("X-Signature"), want)
+	}
+}
+
+func TestHTTPErrorStatus(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusUnauthorized)
+	err := (&Slack{WebhookUrl: server.URL}).Send(context.Background(), model.NotifyMessage{Message: "hello"})
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected HTTPError 401, got %v", err)
+	}
+}
+
+func TestNewFromChannel(t *testing.T) {
+	n, err := New(model.NotifyChannel{ChannelType: "TELEGRAM", Token: "t", Target: "-100"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tg, ok := n.(*Telegram); !ok || tg.ChatId != "-100" {
+		t.Fatalf("unexpected notifier %#v", n)
+	}
+	if _, err := New(model.NotifyChannel{ChannelType: "LINE_NOTIFY"}, nil); !errors.Is(err, ErrUnsupportedChannel) {
+		t.Fatalf("expected ErrUnsupportedChannel, got %v", err)
+	}
+}
+
+func TestFakeRecordsMessages(t *testing.T) {
+	var n Notifier = &Fake{}
+	n.Send(context.Background(), model.NotifyMessage{Message: "one"})
+	n.Send(context.Background(), model.NotifyMessage{Message: "two"})
+	fake := n.(*Fake)
+	if got := fake.Messages(); len(got) != 2 || got[1].Message != "two" {
+		t.Fatalf("unexpected messages %+v", got)
+	}
+	fake.Err = errors.New("down")
+	if err := n.Send(context.Background(), model.NotifyMessage{Message: "three"}); err == nil || len(fake.Messages()) != 2 {
+		t.Fatal("expected the failing fake to drop the message")
+	}
+}
+
+func TestTransportErrorHidesToken(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusOK)
+	server.Close()
+	err := (&Telegram{Token: "123:secret-token", ChatId: "-100", BaseUrl: server.URL}).Send(context.Background(), model.NotifyMessage{Message: "hello"})
+	if err == nil {
+		t.Fatal("expected a transport error")
+	}
+	if strings.Contains(err.Error(), "secret-token") || strings.Contains(err.Error(), "/bot") {
+		t.Fatalf("the error must not carry the url: %v", err)
+	}
+
+	err = (&Discord{WebhookUrl: "http://[::1]:namedport/api/webhooks/1/secret-token"}).Send(context.Background(), model.NotifyMessage{Message: "hello"})
+	if err == nil || strings.Contains(err.Error(), "secret-token") {
+		t.Fatalf("the error must not carry the url: %v", err)
+	}
+}
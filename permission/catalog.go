@@ -0,0 +1,43 @@
+// Package permission holds the permission catalog and seeds the permission table from it.
+package permission
+
+import "github.com/Cyber-Rich-Digital/game-package/model"
+
+// Catalog is the source of truth for permission keys and the menu tree.
+// Add new keys here and list the old key in FormerKeys when renaming, then run Sync.
+var Catalog = []model.PermissionCatalogItem{
+	{PermissionKey: "dashboard", Name: "Dashboard", Title: "แดชบอร์ด", Main: true, Position: 1},
+	{PermissionKey: "admin", Name: "Admin", Title: "ผู้ดูแลระบบ", Main: true, Position: 2, Children: &[]model.PermissionCatalogItem{
+		{PermissionKey: "admin_list", Name: "Admin list", Title: "รายชื่อผู้ดูแล", Position: 1},
+		{PermissionKey: "admin_group", Name: "Admin group", Title: "กลุ่มผู้ดูแล", Position: 2},
+		{PermissionKey: "admin_session", Name: "Admin session", Title: "เซสชันผู้ดูแล", Position: 3},
+	}},
+	{PermissionKey: "member", Name: "Member", Title: "สมาชิก", Main: true, Position: 3, Children: &[]model.PermissionCatalogItem{
+		{PermissionKey: "member_list", Name: "Member list", Title: "รายชื่อสมาชิก", Position: 1},
+		{PermissionKey: "member_update_log", Name: "Member update log", Title: "ประวัติการแก้ไขสมาชิก", Position: 2},
+		{PermissionKey: "member_link", Name: "Linked accounts", Title: "บัญชีที่เชื่อมโยง", Position: 3},
+		{PermissionKey: "scammer", Name: "Scammer", Title: "มิจฉาชีพ", Position: 4},
+		{PermissionKey: "recommend", Name: "Recommend channel", Title: "ช่องทางที่รู้จัก", Position: 5},
+	}},
+	{PermissionKey: "banking", Name: "Banking", Title: "การเงิน", Main: true, Position: 4, Children: &[]model.PermissionCatalogItem{
+		{PermissionKey: "bank_account", Name: "Bank account", Title: "บัญชีธนาคาร", Position: 1},
+		{PermissionKey: "bank_statement", Name: "Bank statement", Title: "รายการเดินบัญชี", Position: 2},
+		{PermissionKey: "deposit", Name: "Deposit", Title: "รายการฝาก", Position: 3},
+		{PermissionKey: "withdraw", Name: "Withdraw", Title: "รายการถอน", Position: 4},
+		{PermissionKey: "approval", Name: "Approval", Title: "อนุมัติรายการ", Position: 5},
+	}},
+	{PermissionKey: "marketing", Name: "Marketing", Title: "การตลาด", Main: true, Position: 5, Children: &[]model.PermissionCatalogItem{
+		{PermissionKey: "promotion", Name: "Promotion", Title: "โปรโมชั่น", Position: 1},
+		{PermissionKey: "turnover", Name: "Turnover", Title: "เทิร์นโอเวอร์", Position: 2},
+		{PermissionKey: "partner", Name: "Partner", Title: "พันธมิตร", Position: 3},
+	}},
+	{PermissionKey: "report", Name: "Report", Title: "รายงาน", Main: true, Position: 6},
+	{PermissionKey: "setting", Name: "Setting", Title: "ตั้งค่า", Main: true, Position: 7, Children: &[]model.PermissionCatalogItem{
+		{PermissionKey: "setting_web", Name: "Web setting", Title: "ตั้งค่าเว็บไซต์", Position: 1},
+		{PermissionKey: "line_notify", Name: "LINE notify", Title: "ไลน์แจ้งเตือน", Position: 2},
+		{PermissionKey: "notify_channel", Name: "Notify channel", Title: "ช่องทางแจ้งเตือน", Position: 3},
+		{PermissionKey: "feature_flag", Name: "Feature flag", Title: "ฟีเจอร์แฟล็ก", Position: 4},
+		{PermissionKey: "security", Name: "Security", Title: "ความปลอดภัย", Position: 5},
+	}},
+	{PermissionKey: "audit_log", Name: "Audit log", Title: "ประวัติการใช้งาน", Main: true, Position: 8},
+}
@@ -0,0 +1,114 @@
+package permission
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"github.com/Cyber-Rich-Digital/game-package/tenant"
+)
+
+// Loader reads the effective permissions of an admin in the tenant carried by ctx, usually by running
+// Effective over the admin's group grants and overrides.
+type Loader interface {
+	LoadEffective(ctx context.Context, adminId int64) (*model.AdminEffectivePermission, error)
+}
+
+type cacheKey struct {
+	tenantId int64
+	adminId  int64
+}
+
+type cacheEntry struct {
+	perms    *model.AdminEffectivePermission
+	byKey    map[string]model.EffectivePermission
+	loadedAt time.Time
+}
+
+// Checker answers Can from an in-memory cache per tenant and admin. Call InvalidateGroup when a group's
+// grants change and InvalidateAdmin when an admin's overrides or group change. TTL bounds how long
+// another instance, which never sees those calls, keeps serving stale grants.
+type Checker struct {
+	loader Loader
+	TTL    time.Duration
+	Now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[cacheKey]*cacheEntry
+}
+
+func NewChecker(loader Loader) *Checker {
+	return &Checker{loader: loader, TTL: time.Minute, Now: time.Now, cache: map[cacheKey]*cacheEntry{}}
+}
+
+// Can reports whether the admin may read or write the permission key. action is "read" or "write".
+func (c *Checker) Can(ctx context.Context, adminId int64, permissionKey string, action string) (bool, error) {
+	entry, err := c.entry(ctx, adminId)
+	if err != nil {
+		return false, err
+	}
+	perm, ok := entry.byKey[permissionKey]
+	if !ok {
+		return false, nil
+	}
+	if action == "write" {
+		return perm.IsWrite, nil
+	}
+	return perm.IsRead, nil
+}
+
+// Effective returns the cached effective permissions of the admin.
+func (c *Checker) Effective(ctx context.Context, adminId int64) (*model.AdminEffectivePermission, error) {
+	entry, err := c.entry(ctx, adminId)
+	if err != nil {
+		return nil, err
+	}
+	return entry.perms, nil
+}
+
+func (c *Checker) entry(ctx context.Context, adminId int64) (*cacheEntry, error) {
+	tenantId, _ := tenant.FromContext(ctx)
+	key := cacheKey{tenantId: tenantId, adminId: adminId}
+	now := c.Now()
+
+	c.mu.Lock()
+	entry, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Sub(entry.loadedAt) < c.TTL {
+		return entry, nil
+	}
+
+	perms, err := c.loader.LoadEffective(ctx, adminId)
+	if err != nil {
+		return nil, err
+	}
+	entry = &cacheEntry{perms: perms, byKey: map[string]model.EffectivePermission{}, loadedAt: now}
+	for _, p := range perms.Permissions {
+		entry.byKey[p.PermissionKey] = p
+	}
+	c.mu.Lock()
+	c.cache[key] = entry
+	c.mu.Unlock()
+	return entry, nil
+}
+
+func (c *Checker) InvalidateAdmin(adminId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.cache {
+		if key.adminId == adminId {
+			delete(c.cache, key)
+		}
+	}
+}
+
+func (c *Checker) InvalidateGroup(groupId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.cache {
+		if entry.perms.GroupId == groupId {
+			delete(c.cache, key)
+		}
+	}
+}
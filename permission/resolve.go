@@ -0,0 +1,147 @@
+package permission
+
+import (
+	"sort"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// Resolve applies an admin override on top of the group grant. Either argument may be nil.
+// A set override flag wins over the group, a nil one inherits it.
+func Resolve(group *model.AdminGroupPermission, override *model.AdminPermission) (isRead bool, isWrite bool, source string) {
+	source = "NONE"
+	if group != nil {
+		isRead, isWrite, source = group.IsRead, group.IsWrite, "GROUP"
+	}
+	if override == nil {
+		return isRead, isWrite, source
+	}
+	if override.IsRead != nil {
+		isRead, source = *override.IsRead, "ADMIN"
+	}
+	if override.IsWrite != nil {
+		isWrite, source = *override.IsWrite, "ADMIN"
+	}
+	return isRead, isWrite, source
+}
+
+// Overrides turns the permissions and overrides of CreateAdmin or AdminBody into AdminPermission rows.
+// A true flag in permissions grants and a false one inherits, as before overrides existed; an entry in
+// overrides replaces the one for the same permission.
+func Overrides(adminId int64, permissions *[]model.PermissionObj, overrides *[]model.AdminPermissionOverride) []model.AdminPermission {
+	byId := map[int64]*model.AdminPermission{}
+	var ids []int64
+	row := func(id int64) *model.AdminPermission {
+		if _, ok := byId[id]; !ok {
+			byId[id] = &model.AdminPermission{AdminId: adminId, PermissionId: id}
+			ids = append(ids, id)
+		}
+		return byId[id]
+	}
+	if permissions != nil {
+		for _, p := range *permissions {
+			r := row(p.Id)
+			r.IsRead, r.IsWrite = grant(p.IsRead), grant(p.IsWrite)
+		}
+	}
+	if overrides != nil {
+		for _, o := range *overrides {
+			r := row(o.Id)
+			r.IsRead, r.IsWrite = mode(o.ReadMode), mode(o.WriteMode)
+		}
+	}
+
+	var out []model.AdminPermission
+	for _, id := range ids {
+		if r := byId[id]; r.IsRead != nil || r.IsWrite != nil {
+			out = append(out, *r)
+		}
+	}
+	return out
+}
+
+func grant(v bool) *bool {
+	if !v {
+		return nil
+	}
+	return &v
+}
+
+func mode(m string) *bool {
+	switch m {
+	case "allow":
+		v := true
+		return &v
+	case "deny":
+		v := false
+		return &v
+	}
+	return nil
+}
+
+// Effective resolves every permission for one admin from the group grants and the admin overrides.
+func Effective(perms []model.Permission, grants []model.AdminGroupPermission, overrides []model.AdminPermission) []model.EffectivePermission {
+	grantById := map[int64]*model.AdminGroupPermission{}
+	for i := range grants {
+		grantById[grants[i].PermissionId] = &grants[i]
+	}
+	overrideById := map[int64]*model.AdminPermission{}
+	for i := range overrides {
+		overrideById[overrides[i].PermissionId] = &overrides[i]
+	}
+
+	out := make([]model.EffectivePermission, 0, len(perms))
+	for _, p := range perms {
+		isRead, isWrite, source := Resolve(grantById[p.Id], overrideById[p.Id])
+		out = append(out, model.EffectivePermission{
+			PermissionId:  p.Id,
+			PermissionKey: p.PermissionKey,
+			Name:          p.Name,
+			Title:         p.Title,
+			Main:          p.Main,
+			ParentId:      p.ParentId,
+			Position:      p.Position,
+			IsRead:        isRead,
+			IsWrite:       isWrite,
+			Source:        source,
+		})
+	}
+	return out
+}
+
+// Menus builds the menu tree from effective permissions, ordered by Position. A menu is readable when
+// it or any of its sub menus is, so the UI can show the way to a sub menu the admin may open.
+func Menus(perms []model.EffectivePermission) []model.Menu {
+	sorted := append([]model.EffectivePermission(nil), perms...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	children := map[int64][]model.SubMenu{}
+	for _, p := range sorted {
+		if p.ParentId == nil {
+			continue
+		}
+		children[*p.ParentId] = append(children[*p.ParentId], model.SubMenu{
+			Id:    p.PermissionId,
+			Title: p.Title,
+			Name:  p.Name,
+			Read:  p.IsRead,
+			Write: p.IsWrite,
+		})
+	}
+
+	var menus []model.Menu
+	for _, p := range sorted {
+		if p.ParentId != nil {
+			continue
+		}
+		menu := model.Menu{Id: p.PermissionId, Title: p.Title, Name: p.Name, Read: p.IsRead, Write: p.IsWrite}
+		if list, ok := children[p.PermissionId]; ok {
+			for _, sub := range list {
+				menu.Read = menu.Read || sub.Read
+			}
+			menu.List = &list
+		}
+		menus = append(menus, menu)
+	}
+	return menus
+}
@@ -0,0 +1,135 @@
+package permission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"github.com/Cyber-Rich-Digital/game-package/tenant"
+)
+
+func boolPtr(v bool) *bool { return &v }
+
+func TestResolve(t *testing.T) {
+	group := &model.AdminGroupPermission{IsRead: true, IsWrite: true}
+	cases := []struct {
+		name     string
+		group    *model.AdminGroupPermission
+		override *model.AdminPermission
+		read     bool
+		write    bool
+		source   string
+	}{
+		{"nothing", nil, nil, false, false, "NONE"},
+		{"group", group, nil, true, true, "GROUP"},
+		{"inherit", group, &model.AdminPermission{}, true, true, "GROUP"},
+		{"deny write", group, &model.AdminPermission{IsWrite: boolPtr(false)}, true, false, "ADMIN"},
+		{"allow without group", nil, &model.AdminPermission{IsRead: boolPtr(true)}, true, false, "ADMIN"},
+	}
+	for _, c := range cases {
+		read, write, source := Resolve(c.group, c.override)
+		if read != c.read || write != c.write || source != c.source {
+			t.Errorf("%s: got %v %v %s, want %v %v %s", c.name, read, write, source, c.read, c.write, c.source)
+		}
+	}
+}
+
+func TestOverridesKeepLegacyBody(t *testing.T) {
+	permissions := []model.PermissionObj{{Id: 1, IsRead: true}, {Id: 2, IsRead: false, IsWrite: false}, {Id: 3, IsRead: true, IsWrite: true}}
+	overrides := []model.AdminPermissionOverride{{Id: 3, ReadMode: "inherit", WriteMode: "deny"}}
+
+	rows := Overrides(9, &permissions, &overrides)
+	if len(rows) != 2 {
+		t.Fatalf("read:false must inherit rather than deny, got %+v", rows)
+	}
+	if rows[0].PermissionId != 1 || rows[0].IsRead == nil || !*rows[0].IsRead || rows[0].IsWrite != nil {
+		t.Fatalf("unexpected grant %+v", rows[0])
+	}
+	if rows[1].PermissionId != 3 || rows[1].IsRead != nil || rows[1].IsWrite == nil || *rows[1].IsWrite {
+		t.Fatalf("the override must replace the legacy entry, got %+v", rows[1])
+	}
+	if rows[0].AdminId != 9 {
+		t.Fatalf("expected the admin id to be set, got %d", rows[0].AdminId)
+	}
+}
+
+func TestMenus(t *testing.T) {
+	member, banking := int64(2), int64(5)
+	perms := []model.Permission{
+		{Id: 5, PermissionKey: "banking", Name: "Banking", Title: "การเงิน", Main: true, Position: 2},
+		{Id: 2, PermissionKey: "member", Name: "Member", Title: "สมาชิก", Main: true, Position: 1},
+		{Id: 4, PermissionKey: "scammer", Name: "Scammer", ParentId: &member, Position: 2},
+		{Id: 3, PermissionKey: "member_list", Name: "Member list", ParentId: &member, Position: 1},
+		{Id: 6, PermissionKey: "deposit", Name: "Deposit", ParentId: &banking, Position: 1},
+	}
+	grants := []model.AdminGroupPermission{{PermissionId: 3, IsRead: true}, {PermissionId: 6, IsRead: true, IsWrite: true}}
+	overrides := []model.AdminPermission{{PermissionId: 6, IsWrite: boolPtr(false)}}
+
+	menus := Menus(Effective(perms, grants, overrides))
+	if len(menus) != 2 || menus[0].Name != "Member" || menus[0].Title != "สมาชิก" {
+		t.Fatalf("unexpected menus %+v", menus)
+	}
+	list := *menus[0].List
+	if len(list) != 2 || list[0].Name != "Member list" || !list[0].Read || list[1].Read {
+		t.Fatalf("unexpected sub menus %+v", list)
+	}
+	if !menus[0].Read || menus[0].Write {
+		t.Fatalf("a readable sub menu must make its menu readable, got %+v", menus[0])
+	}
+	if deposit := (*menus[1].List)[0]; !deposit.Read || deposit.Write {
+		t.Fatalf("the admin deny must win over the group grant, got %+v", deposit)
+	}
+}
+
+type fakeLoader struct {
+	calls int
+	perms map[int64]*model.AdminEffectivePermission
+}
+
+func (l *fakeLoader) LoadEffective(ctx context.Context, adminId int64) (*model.AdminEffectivePermission, error) {
+	l.calls++
+	return l.perms[adminId], nil
+}
+
+func TestCheckerCachesUntilInvalidated(t *testing.T) {
+	loader := &fakeLoader{perms: map[int64]*model.AdminEffectivePermission{
+		1: {AdminId: 1, GroupId: 10, Permissions: []model.EffectivePermission{{PermissionKey: "deposit", IsRead: true}}},
+	}}
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	checker := NewChecker(loader)
+	checker.Now = func() time.Time { return now }
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	for i := 0; i < 3; i++ {
+		if ok, err := checker.Can(ctx, 1, "deposit", "read"); err != nil || !ok {
+			t.Fatalf("expected read access, got %v %v", ok, err)
+		}
+	}
+	if ok, _ := checker.Can(ctx, 1, "deposit", "write"); ok {
+		t.Fatal("expected no write access")
+	}
+	if ok, _ := checker.Can(ctx, 1, "withdraw", "read"); ok {
+		t.Fatal("unknown keys must be denied")
+	}
+	if loader.calls != 1 {
+		t.Fatalf("expected one load, got %d", loader.calls)
+	}
+
+	checker.InvalidateGroup(10)
+	checker.Can(ctx, 1, "deposit", "read")
+	if loader.calls != 2 {
+		t.Fatalf("expected a reload after InvalidateGroup, got %d loads", loader.calls)
+	}
+
+	checker.Can(tenant.NewContext(context.Background(), 8), 1, "deposit", "read")
+	if loader.calls != 3 {
+		t.Fatalf("tenants must not share cache entries, got %d loads", loader.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	checker.Can(ctx, 1, "deposit", "read")
+	if loader.calls != 4 {
+		t.Fatalf("expected a reload after the TTL, got %d loads", loader.calls)
+	}
+}
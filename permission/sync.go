@@ -0,0 +1,218 @@
+package permission
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"github.com/Cyber-Rich-Digital/game-package/tenant"
+	"gorm.io/gorm"
+)
+
+// DuplicateKeyError is returned when a key appears twice in the catalog, as a key or a former key.
+type DuplicateKeyError struct {
+	Key string
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("permission: duplicate catalog key %q", e.Key)
+}
+
+// Action is one write the seeder will make. Permission.ParentId is resolved from ParentKey when applied.
+type Action struct {
+	DriftType  string
+	Permission model.Permission
+	ParentKey  string
+}
+
+type flatItem struct {
+	item      model.PermissionCatalogItem
+	parentKey string
+}
+
+func flatten(items []model.PermissionCatalogItem, parentKey string, out []flatItem) []flatItem {
+	for _, item := range items {
+		out = append(out, flatItem{item: item, parentKey: parentKey})
+		if item.Children != nil {
+			out = flatten(*item.Children, item.PermissionKey, out)
+		}
+	}
+	return out
+}
+
+// Plan compares the catalog with the active permission rows and returns the writes needed to bring
+// them in line, plus the drift to report. Parents always come before their children.
+func Plan(catalog []model.PermissionCatalogItem, rows []model.Permission) ([]Action, []model.PermissionDrift, error) {
+	flat := flatten(catalog, "", nil)
+	seen := map[string]bool{}
+	for _, f := range flat {
+		for _, key := range append([]string{f.item.PermissionKey}, f.item.FormerKeys...) {
+			if seen[key] {
+				return nil, nil, &DuplicateKeyError{Key: key}
+			}
+			seen[key] = true
+		}
+	}
+
+	byKey := map[string]model.Permission{}
+	keyById := map[int64]string{}
+	for _, row := range rows {
+		byKey[row.PermissionKey] = row
+		keyById[row.Id] = row.PermissionKey
+	}
+
+	var actions []Action
+	var drifts []model.PermissionDrift
+	claimed := map[string]bool{}
+	for _, f := range flat {
+		item := f.item
+		want := model.Permission{
+			PermissionKey: item.PermissionKey,
+			Name:          item.Name,
+			Title:         item.Title,
+			Main:          item.Main,
+			Position:      item.Position,
+		}
+		row, found := byKey[item.PermissionKey]
+		formerKey := ""
+		if !found {
+			for _, key := range item.FormerKeys {
+				if old, ok := byKey[key]; ok {
+					row, found, formerKey = old, true, key
+					break
+				}
+			}
+		}
+		if !found {
+			actions = append(actions, Action{DriftType: "MISSING", Permission: want, ParentKey: f.parentKey})
+			drifts = append(drifts, model.PermissionDrift{PermissionKey: item.PermissionKey, DriftType: "MISSING", CatalogName: item.Name})
+			continue
+		}
+		claimed[row.PermissionKey] = true
+
+		currentParent := ""
+		if row.ParentId != nil {
+			currentParent = keyById[*row.ParentId]
+		}
+		want.Id = row.Id
+		want.ParentId = row.ParentId
+		switch {
+		case formerKey != "":
+			actions = append(actions, Action{DriftType: "RENAMED", Permission: want, ParentKey: f.parentKey})
+			drifts = append(drifts, model.PermissionDrift{PermissionKey: item.PermissionKey, DriftType: "RENAMED", FormerKey: formerKey, CatalogName: item.Name, DbName: row.Name})
+		case row.Name != want.Name || row.Title != want.Title || row.Main != want.Main || row.Position != want.Position || currentParent != f.parentKey:
+			actions = append(actions, Action{DriftType: "CHANGED", Permission: want, ParentKey: f.parentKey})
+			drifts = append(drifts, model.PermissionDrift{PermissionKey: item.PermissionKey, DriftType: "CHANGED", CatalogName: item.Name, DbName: row.Name})
+		default:
+			actions = append(actions, Action{DriftType: "", Permission: want, ParentKey: f.parentKey})
+		}
+	}
+
+	for _, row := range rows {
+		if claimed[row.PermissionKey] {
+			continue
+		}
+		actions = append(actions, Action{DriftType: "ORPHANED", Permission: row})
+		drifts = append(drifts, model.PermissionDrift{PermissionKey: row.PermissionKey, DriftType: "ORPHANED", DbName: row.Name})
+	}
+	return actions, drifts, nil
+}
+
+// Sync seeds the permission table from Catalog. It is safe to run on every deploy: unchanged rows are
+// left alone and orphaned rows are only soft deleted when RemoveOrphans is set.
+func Sync(db *gorm.DB, req model.PermissionSyncRequest) (*model.PermissionSyncResponse, error) {
+	result := model.PermissionSyncResponse{DryRun: req.DryRun}
+	// Drift looks at group grants in every tenant.
+	db = db.WithContext(tenant.Global(db.Statement.Context))
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var rows []model.Permission
+		if err := tx.Where("deleted_at IS NULL").Find(&rows).Error; err != nil {
+			return err
+		}
+		actions, drifts, err := Plan(Catalog, rows)
+		if err != nil {
+			return err
+		}
+
+		for i, drift := range drifts {
+			if drift.DriftType != "ORPHANED" {
+				continue
+			}
+			id := idByKey(rows, drift.PermissionKey)
+			if err := tx.Model(&model.AdminGroupPermission{}).Where("permission_id = ? AND deleted_at IS NULL", id).Pluck("group_id", &drifts[i].GroupIds).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&model.AdminPermission{}).Where("permission_id = ?", id).Pluck("admin_id", &drifts[i].AdminIds).Error; err != nil {
+				return err
+			}
+		}
+		result.Drifts = drifts
+
+		ids := map[string]int64{}
+		now := time.Now()
+		for _, action := range actions {
+			perm := action.Permission
+			if action.ParentKey != "" {
+				if parentId, ok := ids[action.ParentKey]; ok {
+					perm.ParentId = &parentId
+				}
+			} else {
+				perm.ParentId = nil
+			}
+			switch action.DriftType {
+			case "MISSING":
+				result.Created++
+				if !req.DryRun {
+					if err := tx.Create(&perm).Error; err != nil {
+						return err
+					}
+				}
+			case "RENAMED", "CHANGED":
+				if action.DriftType == "RENAMED" {
+					result.Renamed++
+				} else {
+					result.Updated++
+				}
+				if !req.DryRun {
+					if err := tx.Model(&model.Permission{}).Where("id = ?", perm.Id).Updates(map[string]interface{}{
+						"permission_key": perm.PermissionKey,
+						"name":           perm.Name,
+						"title":          perm.Title,
+						"main":           perm.Main,
+						"position":       perm.Position,
+						"parent_id":      perm.ParentId,
+					}).Error; err != nil {
+						return err
+					}
+				}
+			case "ORPHANED":
+				if !req.RemoveOrphans {
+					continue
+				}
+				result.Removed++
+				if !req.DryRun {
+					if err := tx.Model(&model.Permission{}).Where("id = ?", perm.Id).Update("deleted_at", now).Error; err != nil {
+						return err
+					}
+				}
+			default:
+				result.Unchanged++
+			}
+			ids[perm.PermissionKey] = perm.Id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func idByKey(rows []model.Permission, key string) int64 {
+	for _, row := range rows {
+		if row.PermissionKey == key {
+			return row.Id
+		}
+	}
+	return 0
+}
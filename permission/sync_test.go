@@ -0,0 +1,121 @@
+package permission
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func planByKey(t *testing.T, catalog []model.PermissionCatalogItem, rows []model.Permission) map[string]Action {
+	t.Helper()
+	actions, _, err := Plan(catalog, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := map[string]Action{}
+	for _, action := range actions {
+		out[action.Permission.PermissionKey] = action
+	}
+	return out
+}
+
+func TestPlanCreatesMissingParentsFirst(t *testing.T) {
+	catalog := []model.PermissionCatalogItem{
+		{PermissionKey: "member", Name: "Member", Main: true, Position: 1, Children: &[]model.PermissionCatalogItem{
+			{PermissionKey: "member_list", Name: "Member list", Position: 1},
+		}},
+	}
+	actions, drifts, err := Plan(catalog, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 || actions[0].Permission.PermissionKey != "member" || actions[1].ParentKey != "member" {
+		t.Fatalf("unexpected actions %+v", actions)
+	}
+	if len(drifts) != 2 || drifts[0].DriftType != "MISSING" {
+		t.Fatalf("unexpected drifts %+v", drifts)
+	}
+}
+
+func TestPlanRenameViaFormerKeys(t *testing.T) {
+	catalog := []model.PermissionCatalogItem{
+		{PermissionKey: "member_link", Name: "Linked accounts", Position: 3, FormerKeys: []string{"member_cluster"}},
+	}
+	rows := []model.Permission{{Id: 9, PermissionKey: "member_cluster", Name: "Member cluster", Position: 3}}
+
+	actions, drifts, err := Plan(catalog, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].DriftType != "RENAMED" || actions[0].Permission.Id != 9 {
+		t.Fatalf("expected the row to be renamed in place, got %+v", actions)
+	}
+	if drifts[0].FormerKey != "member_cluster" || drifts[0].DbName != "Member cluster" {
+		t.Fatalf("unexpected drift %+v", drifts[0])
+	}
+}
+
+func TestPlanParentChange(t *testing.T) {
+	catalog := []model.PermissionCatalogItem{
+		{PermissionKey: "member", Name: "Member", Main: true, Position: 1},
+		{PermissionKey: "banking", Name: "Banking", Main: true, Position: 2, Children: &[]model.PermissionCatalogItem{
+			{PermissionKey: "deposit", Name: "Deposit", Position: 1},
+		}},
+	}
+	rows := []model.Permission{
+		{Id: 1, PermissionKey: "member", Name: "Member", Main: true, Position: 1},
+		{Id: 2, PermissionKey: "banking", Name: "Banking", Main: true, Position: 2},
+		{Id: 3, PermissionKey: "deposit", Name: "Deposit", Position: 1, ParentId: int64Ptr(1)},
+	}
+	got := planByKey(t, catalog, rows)
+	if got["member"].DriftType != "" || got["banking"].DriftType != "" {
+		t.Fatalf("expected unchanged parents, got %+v", got)
+	}
+	if got["deposit"].DriftType != "CHANGED" || got["deposit"].ParentKey != "banking" {
+		t.Fatalf("expected deposit to move under banking, got %+v", got["deposit"])
+	}
+}
+
+func TestPlanOrphans(t *testing.T) {
+	catalog := []model.PermissionCatalogItem{{PermissionKey: "dashboard", Name: "Dashboard", Main: true, Position: 1}}
+	rows := []model.Permission{
+		{Id: 1, PermissionKey: "dashboard", Name: "Dashboard", Main: true, Position: 1},
+		{Id: 2, PermissionKey: "lotto", Name: "Lotto"},
+	}
+	_, drifts, err := Plan(catalog, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(drifts) != 1 || drifts[0].DriftType != "ORPHANED" || drifts[0].PermissionKey != "lotto" {
+		t.Fatalf("unexpected drifts %+v", drifts)
+	}
+}
+
+func TestPlanRejectsDuplicateKeys(t *testing.T) {
+	cases := map[string][]model.PermissionCatalogItem{
+		"key": {
+			{PermissionKey: "report", Name: "Report"},
+			{PermissionKey: "setting", Name: "Setting", Children: &[]model.PermissionCatalogItem{{PermissionKey: "report", Name: "Report"}}},
+		},
+		"former key": {
+			{PermissionKey: "report", Name: "Report"},
+			{PermissionKey: "summary", Name: "Summary", FormerKeys: []string{"report"}},
+		},
+	}
+	for name, catalog := range cases {
+		_, _, err := Plan(catalog, nil)
+		var dup *DuplicateKeyError
+		if !errors.As(err, &dup) || dup.Key != "report" {
+			t.Fatalf("%s: expected a duplicate key error, got %v", name, err)
+		}
+	}
+}
+
+func TestCatalogIsValid(t *testing.T) {
+	if _, _, err := Plan(Catalog, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,219 @@
+package tenant
+
+import (
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// Plugin enforces tenant isolation on every model with a TenantId field. Register it once with
+// db.Use(&tenant.Plugin{}) and pass NewContext or Global through db.WithContext.
+//
+// Queries, updates and deletes get a tenant_id condition and creates get TenantId filled in. An update
+// never writes tenant_id: a zero TenantId in the body is left out, and any other value than the current
+// tenant fails with ErrMismatch, as does a create for another tenant. A statement without a tenant in its
+// context fails with ErrRequired.
+//
+// Models without TenantId are global and untouched. Statements the plugin cannot see the model of, such as
+// db.Table("promotions").Find(&maps), fail with ErrUnscoped unless the context is Global. Raw and Exec
+// statements are not scoped at all: add the tenant_id condition to the SQL yourself.
+type Plugin struct{}
+
+func (p *Plugin) Name() string {
+	return "tenant"
+}
+
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register("tenant:create", tenantCreate); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("tenant:query", tenantWhere(false)); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().Before("gorm:row").Register("tenant:row", tenantWhere(false)); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").Register("tenant:update", tenantUpdate); err != nil {
+		return err
+	}
+	return db.Callback().Delete().Before("gorm:delete").Register("tenant:delete", tenantWhere(true))
+}
+
+// tenantField returns the TenantId field and the tenant to apply, or false when the statement is
+// global, has no TenantId or already failed.
+func tenantField(db *gorm.DB) (*schema.Field, int64, bool) {
+	if db.Error != nil {
+		return nil, 0, false
+	}
+	stmt := db.Statement
+	if stmt.Schema == nil {
+		// Raw statements arrive with their SQL already built.
+		if stmt.SQL.Len() == 0 && !isGlobal(stmt.Context) {
+			db.AddError(ErrUnscoped)
+		}
+		return nil, 0, false
+	}
+	field := stmt.Schema.LookUpField("TenantId")
+	if field == nil {
+		return nil, 0, false
+	}
+	tc, ok := stmt.Context.Value(contextKey{}).(tenantContext)
+	if ok && tc.global {
+		return nil, 0, false
+	}
+	if !ok || tc.tenantId == 0 {
+		db.AddError(ErrRequired)
+		return nil, 0, false
+	}
+	return field, tc.tenantId, true
+}
+
+func tenantWhere(guardGlobal bool) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		field, tenantId, ok := tenantField(db)
+		if !ok {
+			return
+		}
+		addCondition(db, field, tenantId, guardGlobal)
+	}
+}
+
+func addCondition(db *gorm.DB, field *schema.Field, tenantId int64, guardGlobal bool) {
+	// The tenant condition would otherwise satisfy gorm's missing WHERE check and turn a
+	// forgotten condition into a tenant wide update or delete.
+	if guardGlobal && !db.AllowGlobalUpdate && !hasConditions(db.Statement) {
+		db.AddError(gorm.ErrMissingWhereClause)
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantId},
+	}})
+}
+
+func hasConditions(stmt *gorm.Statement) bool {
+	if _, ok := stmt.Clauses["WHERE"]; ok {
+		return true
+	}
+	rv := stmt.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv.Len() > 0
+	case reflect.Struct:
+		for _, field := range stmt.Schema.PrimaryFields {
+			if _, zero := field.ValueOf(stmt.Context, rv); !zero {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tenantUpdate scopes the update and keeps tenant_id out of the assignments. Save sends the whole
+// struct, so a zero TenantId would otherwise be written as tenant_id = 0.
+func tenantUpdate(db *gorm.DB) {
+	field, tenantId, ok := tenantField(db)
+	if !ok {
+		return
+	}
+	switch dest := db.Statement.Dest.(type) {
+	case map[string]interface{}:
+		checkMap(db, field, tenantId, dest, false)
+	case *map[string]interface{}:
+		checkMap(db, field, tenantId, *dest, false)
+	default:
+		rv := reflect.Indirect(reflect.ValueOf(dest))
+		if rv.Kind() != reflect.Struct {
+			break
+		}
+		value := rv.FieldByName(field.Name)
+		if !value.IsValid() {
+			break
+		}
+		if value.IsZero() {
+			db.Statement.Omits = append(db.Statement.Omits, field.DBName)
+		} else if id, ok := toInt64(value.Interface()); !ok || id != tenantId {
+			db.AddError(ErrMismatch)
+		}
+	}
+	if db.Error != nil {
+		return
+	}
+	addCondition(db, field, tenantId, true)
+}
+
+// checkMap fails when the map sets tenant_id to another tenant. With fill set, a map without
+// tenant_id gets the current tenant.
+func checkMap(db *gorm.DB, field *schema.Field, tenantId int64, values map[string]interface{}, fill bool) {
+	for key, value := range values {
+		if f := db.Statement.Schema.LookUpField(key); f == nil || f.DBName != field.DBName {
+			continue
+		}
+		if id, ok := toInt64(value); !ok || id != tenantId {
+			db.AddError(ErrMismatch)
+		}
+		return
+	}
+	if fill {
+		values[field.DBName] = tenantId
+	}
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(value))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), true
+	}
+	return 0, false
+}
+
+func tenantCreate(db *gorm.DB) {
+	field, tenantId, ok := tenantField(db)
+	if !ok {
+		return
+	}
+	switch dest := db.Statement.Dest.(type) {
+	case map[string]interface{}:
+		checkMap(db, field, tenantId, dest, true)
+		return
+	case *map[string]interface{}:
+		checkMap(db, field, tenantId, *dest, true)
+		return
+	case []map[string]interface{}:
+		for _, values := range dest {
+			checkMap(db, field, tenantId, values, true)
+		}
+		return
+	case *[]map[string]interface{}:
+		for _, values := range *dest {
+			checkMap(db, field, tenantId, values, true)
+		}
+		return
+	}
+
+	set := func(rv reflect.Value) {
+		value, zero := field.ValueOf(db.Statement.Context, rv)
+		if zero {
+			if err := field.Set(db.Statement.Context, rv, tenantId); err != nil {
+				db.AddError(err)
+			}
+			return
+		}
+		if id, ok := toInt64(value); !ok || id != tenantId {
+			db.AddError(ErrMismatch)
+		}
+	}
+	rv := db.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			set(reflect.Indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		set(rv)
+	}
+}
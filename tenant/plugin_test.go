@@ -0,0 +1,261 @@
+package tenant_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+	"github.com/Cyber-Rich-Digital/game-package/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// dryRunDialector builds MySQL flavoured SQL without a database connection.
+type dryRunDialector struct{}
+
+func (dryRunDialector) Name() string { return "dryrun" }
+
+func (dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	return nil
+}
+
+func (dryRunDialector) Migrator(db *gorm.DB) gorm.Migrator { return nil }
+
+func (dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (dryRunDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	writer.WriteByte('?')
+}
+
+func (dryRunDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteByte('`')
+	writer.WriteString(str)
+	writer.WriteByte('`')
+}
+
+func (dryRunDialector) Explain(sql string, vars ...interface{}) string { return sql }
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Use(&tenant.Plugin{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func assertTenantCondition(t *testing.T, stmt *gorm.Statement, table string, tenantId int64) {
+	t.Helper()
+	sql := stmt.SQL.String()
+	if !strings.Contains(sql, "`"+table+"`.`tenant_id` = ?") {
+		t.Fatalf("missing tenant condition: %s", sql)
+	}
+	for _, v := range stmt.Vars {
+		if v == tenantId {
+			return
+		}
+	}
+	t.Fatalf("tenant id %d not bound in %v", tenantId, stmt.Vars)
+}
+
+func TestPluginQuery(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	var list []model.Promotion
+	tx := db.WithContext(ctx).Where("status = ?", "ACTIVE").Find(&list)
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	assertTenantCondition(t, tx.Statement, "promotions", 7)
+
+	var count int64
+	tx = db.WithContext(ctx).Model(&model.Promotion{}).Count(&count)
+	assertTenantCondition(t, tx.Statement, "promotions", 7)
+}
+
+func TestPluginRequiresTenant(t *testing.T) {
+	db := newTestDB(t)
+
+	var list []model.Promotion
+	if err := db.Find(&list).Error; !errors.Is(err, tenant.ErrRequired) {
+		t.Fatalf("expected tenant.ErrRequired without a tenant, got %v", err)
+	}
+	if err := db.WithContext(tenant.NewContext(context.Background(), 0)).Find(&list).Error; !errors.Is(err, tenant.ErrRequired) {
+		t.Fatalf("expected tenant.ErrRequired for tenant 0, got %v", err)
+	}
+}
+
+func TestPluginGlobal(t *testing.T) {
+	db := newTestDB(t)
+
+	var list []model.Promotion
+	tx := db.WithContext(tenant.Global(context.Background())).Find(&list)
+	if tx.Error != nil || strings.Contains(tx.Statement.SQL.String(), "tenant_id") {
+		t.Fatalf("expected an unscoped query, got %v %s", tx.Error, tx.Statement.SQL.String())
+	}
+
+	var permissions []model.Permission
+	tx = db.Find(&permissions)
+	if tx.Error != nil || strings.Contains(tx.Statement.SQL.String(), "tenant_id") {
+		t.Fatalf("models without TenantId must stay global, got %v %s", tx.Error, tx.Statement.SQL.String())
+	}
+
+	var memberships []model.AdminTenant
+	tx = db.Where("admin_id = ?", 1).Find(&memberships)
+	if tx.Error != nil || strings.Contains(tx.Statement.SQL.String(), "`tenant_id`") {
+		t.Fatalf("admin tenants must be listed across tenants, got %v %s", tx.Error, tx.Statement.SQL.String())
+	}
+}
+
+func TestPluginCreate(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	promotion := model.Promotion{Name: "Welcome"}
+	if err := db.WithContext(ctx).Create(&promotion).Error; err != nil {
+		t.Fatal(err)
+	}
+	if promotion.TenantId != 7 {
+		t.Fatalf("expected TenantId to be filled, got %d", promotion.TenantId)
+	}
+
+	other := model.Promotion{Name: "Other", TenantId: 8}
+	if err := db.WithContext(ctx).Create(&other).Error; !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch, got %v", err)
+	}
+}
+
+func TestPluginCreateMap(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	values := map[string]interface{}{"name": "Welcome"}
+	tx := db.WithContext(ctx).Model(&model.Promotion{}).Create(values)
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	if values["tenant_id"] != int64(7) || !strings.Contains(tx.Statement.SQL.String(), "`tenant_id`") {
+		t.Fatalf("expected tenant_id to be inserted, got %v %s", values, tx.Statement.SQL.String())
+	}
+
+	rows := []map[string]interface{}{{"name": "One"}, {"name": "Two", "TenantId": int64(8)}}
+	if err := db.WithContext(ctx).Model(&model.Promotion{}).Create(rows).Error; !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch, got %v", err)
+	}
+}
+
+func TestPluginUpdateAndDelete(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	tx := db.WithContext(ctx).Model(&model.Promotion{Id: 1}).Update("status", "DEACTIVE")
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	if !strings.Contains(tx.Statement.SQL.String(), "`id` = ?") {
+		t.Fatalf("expected the primary key condition, got %s", tx.Statement.SQL.String())
+	}
+	assertTenantCondition(t, tx.Statement, "promotions", 7)
+
+	tx = db.WithContext(ctx).Delete(&model.Promotion{}, 1)
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	assertTenantCondition(t, tx.Statement, "promotions", 7)
+
+	if err := db.WithContext(ctx).Model(&model.Promotion{}).Update("status", "DEACTIVE").Error; !errors.Is(err, gorm.ErrMissingWhereClause) {
+		t.Fatalf("the tenant condition must not allow a tenant wide update, got %v", err)
+	}
+	if err := db.WithContext(ctx).Delete(&model.Promotion{}).Error; !errors.Is(err, gorm.ErrMissingWhereClause) {
+		t.Fatalf("the tenant condition must not allow a tenant wide delete, got %v", err)
+	}
+}
+
+func TestPluginSaveKeepsTenant(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	tx := db.WithContext(ctx).Save(&model.Promotion{Id: 1, Name: "Welcome"})
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	sql := tx.Statement.SQL.String()
+	if strings.Contains(sql, "`tenant_id`=?") {
+		t.Fatalf("save must not write tenant_id: %s", sql)
+	}
+	assertTenantCondition(t, tx.Statement, "promotions", 7)
+
+	if err := db.WithContext(ctx).Save(&model.Promotion{Id: 1, TenantId: 8}).Error; !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch, got %v", err)
+	}
+}
+
+func TestPluginUpdateRejectsTenantChange(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	err := db.WithContext(ctx).Model(&model.Promotion{Id: 1}).Updates(map[string]interface{}{"tenant_id": 8}).Error
+	if !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch for a map update, got %v", err)
+	}
+	if err := db.WithContext(ctx).Model(&model.Promotion{Id: 1}).Update("TenantId", 8).Error; !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch for a column update, got %v", err)
+	}
+	if err := db.WithContext(ctx).Model(&model.Promotion{Id: 1}).Updates(model.Promotion{Name: "x", TenantId: 8}).Error; !errors.Is(err, tenant.ErrMismatch) {
+		t.Fatalf("expected tenant.ErrMismatch for a struct update, got %v", err)
+	}
+	if err := db.WithContext(ctx).Model(&model.Promotion{Id: 1}).Updates(map[string]interface{}{"tenant_id": int64(7), "name": "x"}).Error; err != nil {
+		t.Fatalf("setting the current tenant is harmless, got %v", err)
+	}
+}
+
+func TestPluginRejectsSchemalessStatements(t *testing.T) {
+	db := newTestDB(t)
+	ctx := tenant.NewContext(context.Background(), 7)
+
+	var rows []map[string]interface{}
+	if err := db.WithContext(ctx).Table("promotions").Find(&rows).Error; !errors.Is(err, tenant.ErrUnscoped) {
+		t.Fatalf("expected tenant.ErrUnscoped for Find, got %v", err)
+	}
+	var count int64
+	if err := db.WithContext(ctx).Table("promotions").Count(&count).Error; !errors.Is(err, tenant.ErrUnscoped) {
+		t.Fatalf("expected tenant.ErrUnscoped for Count, got %v", err)
+	}
+	if err := db.WithContext(tenant.Global(context.Background())).Table("promotions").Count(&count).Error; err != nil {
+		t.Fatalf("global statements may skip the model, got %v", err)
+	}
+	if err := db.WithContext(ctx).Raw("SELECT * FROM promotions WHERE tenant_id = ?", 7).Find(&rows).Error; err != nil {
+		t.Fatalf("raw statements are left alone, got %v", err)
+	}
+}
+
+func TestScope(t *testing.T) {
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var list []model.Promotion
+	tx := db.Scopes(tenant.Scope(3)).Find(&list)
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	assertTenantCondition(t, tx.Statement, "promotions", 3)
+
+	if err := db.Scopes(tenant.Scope(0)).Find(&list).Error; !errors.Is(err, tenant.ErrRequired) {
+		t.Fatalf("expected tenant.ErrRequired, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+// Package tenant carries the current tenant through a context and enforces it on gorm statements.
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrRequired = errors.New("tenant id is required")
+	ErrMismatch = errors.New("tenant id does not match the current tenant")
+	ErrUnscoped = errors.New("statement has no model to scope by tenant")
+)
+
+type contextKey struct{}
+
+type tenantContext struct {
+	tenantId int64
+	global   bool
+}
+
+// NewContext marks every query run with this context as belonging to the tenant.
+func NewContext(ctx context.Context, tenantId int64) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantContext{tenantId: tenantId})
+}
+
+// Global lets platform jobs and tenant management read across tenants. Never derive it from a request.
+func Global(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantContext{global: true})
+}
+
+func FromContext(ctx context.Context) (int64, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	tc, ok := ctx.Value(contextKey{}).(tenantContext)
+	if !ok || tc.global || tc.tenantId == 0 {
+		return 0, false
+	}
+	return tc.tenantId, true
+}
+
+func isGlobal(ctx context.Context) bool {
+	tc, ok := ctx.Value(contextKey{}).(tenantContext)
+	return ok && tc.global
+}
+
+// Scope filters one query by tenant. Prefer Plugin, which applies the filter to every query.
+func Scope(tenantId int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tenantId == 0 {
+			db.AddError(ErrRequired)
+			return db
+		}
+		return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "tenant_id"}, Value: tenantId})
+	}
+}
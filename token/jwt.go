@@ -0,0 +1,77 @@
+// Package token issues and verifies the signed access tokens and refresh tokens of admin and member sessions.
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+var (
+	ErrInvalidToken = errors.New("token: invalid token")
+	ErrExpired      = errors.New("token: expired")
+	ErrRevoked      = errors.New("token: session revoked")
+	// ErrStale means the group or admin permissions changed after the token was issued. The client
+	// should refresh to get a token with the current permissions.
+	ErrStale = errors.New("token: permissions changed")
+)
+
+const (
+	TypeAccess = "access"
+)
+
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Signer signs TokenClaims as HS256 JWTs.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner takes the shared secret. Use at least 32 random bytes.
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+func (s *Signer) Sign(claims model.TokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + s.signature(unsigned), nil
+}
+
+// Parse checks the signature and expiry and returns the claims.
+func (s *Signer) Parse(token string, now time.Time) (model.TokenClaims, error) {
+	var claims model.TokenClaims
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return claims, ErrInvalidToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(parts[0]+"."+parts[1]))) {
+		return claims, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, ErrInvalidToken
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, ErrInvalidToken
+	}
+	if now.Unix() >= claims.ExpiredAt {
+		return claims, ErrExpired
+	}
+	return claims, nil
+}
+
+func (s *Signer) signature(unsigned string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,250 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// Store keeps Session rows. Implementations must be safe for concurrent use.
+type Store interface {
+	// GetSession returns nil when the session does not exist.
+	GetSession(ctx context.Context, sessionId string) (*model.Session, error)
+	SaveSession(ctx context.Context, session model.Session) error
+	ListSessions(ctx context.Context, actorType string, actorId int64) ([]model.Session, error)
+}
+
+// ClaimsLoader reads the current claims of a session's actor: group, role and permission versions.
+// It should return ErrRevoked when the admin or user may no longer log in, e.g. Status is DEACTIVE.
+type ClaimsLoader func(ctx context.Context, session model.Session) (model.TokenClaims, error)
+
+// Versions are the current permission counters, read from the admin's group and Admin.PermissionVersion.
+type Versions struct {
+	PermissionVersion      int64
+	AdminPermissionVersion int64
+}
+
+// Tokens is what a login or refresh returns to the client.
+type Tokens struct {
+	Token        string
+	RefreshToken string
+	ExpiredAt    time.Time
+	Claims       model.TokenClaims
+}
+
+// Issuer creates sessions and the tokens that belong to them. Access tokens are short lived JWTs.
+// Refresh tokens are opaque "<sessionId>.<secret>" strings; only a hash of the secret is stored, and
+// every refresh replaces it, so presenting an old refresh token revokes the session.
+type Issuer struct {
+	signer     *Signer
+	store      Store
+	load       ClaimsLoader
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	Now        func() time.Time
+}
+
+func NewIssuer(signer *Signer, store Store, load ClaimsLoader) *Issuer {
+	return &Issuer{
+		signer:     signer,
+		store:      store,
+		load:       load,
+		AccessTTL:  15 * time.Minute,
+		RefreshTTL: 30 * 24 * time.Hour,
+		Now:        time.Now,
+	}
+}
+
+// Login starts a session for claims, which must carry ActorType and the AdminId or UserId.
+func (i *Issuer) Login(ctx context.Context, claims model.TokenClaims, ip, userAgent string) (*Tokens, error) {
+	now := i.Now()
+	sessionId, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	session := model.Session{
+		TenantId:         claims.TenantId,
+		SessionId:        sessionId,
+		ActorType:        claims.ActorType,
+		ActorId:          actorId(claims),
+		RefreshTokenHash: hashSecret(secret),
+		Ip:               ip,
+		UserAgent:        userAgent,
+		ExpiredAt:        now.Add(i.RefreshTTL),
+		LastUsedAt:       &now,
+		CreatedAt:        now,
+	}
+	if err := i.store.SaveSession(ctx, session); err != nil {
+		return nil, err
+	}
+	return i.issue(claims, session, secret, now)
+}
+
+// Refresh rotates the refresh token and issues an access token with the actor's current claims.
+func (i *Issuer) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	now := i.Now()
+	sessionId, secret, ok := strings.Cut(refreshToken, ".")
+	if !ok || sessionId == "" || secret == "" {
+		return nil, ErrInvalidToken
+	}
+	session, err := i.active(ctx, sessionId, now)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(session.RefreshTokenHash)) != 1 {
+		// An old refresh token was replayed: whoever holds it is not the client that rotated it.
+		if err := i.revoke(ctx, session, "REFRESH_REUSED", "", now); err != nil {
+			return nil, err
+		}
+		return nil, ErrRevoked
+	}
+
+	claims, err := i.load(ctx, *session)
+	if err != nil {
+		if errors.Is(err, ErrRevoked) {
+			if err := i.revoke(ctx, session, "STATUS_CHANGED", "", now); err != nil {
+				return nil, err
+			}
+			return nil, ErrRevoked
+		}
+		return nil, err
+	}
+	next, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshTokenHash = hashSecret(next)
+	session.LastUsedAt = &now
+	session.UpdatedAt = &now
+	if err := i.store.SaveSession(ctx, *session); err != nil {
+		return nil, err
+	}
+	return i.issue(claims, *session, next, now)
+}
+
+// Authenticate verifies an access token, checks that its session is still active and that the
+// permission versions it carries are current.
+func (i *Issuer) Authenticate(ctx context.Context, token string, current Versions) (model.TokenClaims, error) {
+	now := i.Now()
+	claims, err := i.signer.Parse(token, now)
+	if err != nil {
+		return claims, err
+	}
+	if claims.TokenType != TypeAccess {
+		return claims, ErrInvalidToken
+	}
+	if _, err := i.active(ctx, claims.SessionId, now); err != nil {
+		return claims, err
+	}
+	if Stale(claims, current) {
+		return claims, ErrStale
+	}
+	return claims, nil
+}
+
+// Stale reports whether the token was issued before the latest group or admin permission change.
+func Stale(claims model.TokenClaims, current Versions) bool {
+	return claims.PermissionVersion != current.PermissionVersion || claims.AdminPermissionVersion != current.AdminPermissionVersion
+}
+
+// Revoke ends one session when body.SessionId is set, or every active session of the actor otherwise,
+// e.g. after a status or password change.
+func (i *Issuer) Revoke(ctx context.Context, body model.SessionRevokeBody) error {
+	now := i.Now()
+	if body.SessionId != nil {
+		session, err := i.store.GetSession(ctx, *body.SessionId)
+		if err != nil {
+			return err
+		}
+		if session == nil || session.ActorType != body.ActorType || session.ActorId != body.ActorId {
+			return ErrInvalidToken
+		}
+		if session.RevokedAt != nil {
+			return nil
+		}
+		return i.revoke(ctx, session, body.RevokedReason, body.RevokedByUsername, now)
+	}
+	sessions, err := i.store.ListSessions(ctx, body.ActorType, body.ActorId)
+	if err != nil {
+		return err
+	}
+	for idx := range sessions {
+		if sessions[idx].RevokedAt != nil {
+			continue
+		}
+		if err := i.revoke(ctx, &sessions[idx], body.RevokedReason, body.RevokedByUsername, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Issuer) active(ctx context.Context, sessionId string, now time.Time) (*model.Session, error) {
+	session, err := i.store.GetSession(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrInvalidToken
+	}
+	if session.RevokedAt != nil {
+		return nil, ErrRevoked
+	}
+	if !now.Before(session.ExpiredAt) {
+		return nil, ErrExpired
+	}
+	return session, nil
+}
+
+func (i *Issuer) revoke(ctx context.Context, session *model.Session, reason, by string, now time.Time) error {
+	session.RevokedAt = &now
+	session.RevokedReason = reason
+	session.RevokedByUsername = by
+	session.UpdatedAt = &now
+	return i.store.SaveSession(ctx, *session)
+}
+
+func (i *Issuer) issue(claims model.TokenClaims, session model.Session, secret string, now time.Time) (*Tokens, error) {
+	expiredAt := now.Add(i.AccessTTL)
+	claims.SessionId = session.SessionId
+	claims.TokenType = TypeAccess
+	claims.IssuedAt = now.Unix()
+	claims.ExpiredAt = expiredAt.Unix()
+	token, err := i.signer.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{Token: token, RefreshToken: session.SessionId + "." + secret, ExpiredAt: expiredAt, Claims: claims}, nil
+}
+
+func actorId(claims model.TokenClaims) int64 {
+	if claims.ActorType == "USER" {
+		return claims.UserId
+	}
+	return claims.AdminId
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,47 @@
+package token
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// MemoryStore keeps sessions in memory, for tests and single instance tools.
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]model.Session
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: map[string]model.Session{}}
+}
+
+func (s *MemoryStore) GetSession(ctx context.Context, sessionId string) (*model.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[sessionId]
+	if !ok {
+		return nil, nil
+	}
+	return &session, nil
+}
+
+func (s *MemoryStore) SaveSession(ctx context.Context, session model.Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[session.SessionId] = session
+	return nil
+}
+
+func (s *MemoryStore) ListSessions(ctx context.Context, actorType string, actorId int64) ([]model.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []model.Session
+	for _, session := range s.sessions {
+		if session.ActorType == actorType && session.ActorId == actorId {
+			out = append(out, session)
+		}
+	}
+	return out, nil
+}
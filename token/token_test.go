@@ -0,0 +1,178 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+type testActor struct {
+	status   string
+	versions Versions
+}
+
+func newTestIssuer(t *testing.T) (*Issuer, *MemoryStore, *testActor, *time.Time) {
+	t.Helper()
+	actor := &testActor{status: "ACTIVE", versions: Versions{PermissionVersion: 1, AdminPermissionVersion: 1}}
+	store := NewMemoryStore()
+	load := func(ctx context.Context, session model.Session) (model.TokenClaims, error) {
+		if actor.status != "ACTIVE" {
+			return model.TokenClaims{}, ErrRevoked
+		}
+		return model.TokenClaims{
+			TenantId:               session.TenantId,
+			ActorType:              session.ActorType,
+			AdminId:                session.ActorId,
+			GroupId:                3,
+			PermissionVersion:      actor.versions.PermissionVersion,
+			AdminPermissionVersion: actor.versions.AdminPermissionVersion,
+		}, nil
+	}
+	now := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	issuer := NewIssuer(NewSigner([]byte("0123456789abcdef0123456789abcdef")), store, load)
+	issuer.Now = func() time.Time { return now }
+	return issuer, store, actor, &now
+}
+
+func login(t *testing.T, issuer *Issuer) *Tokens {
+	t.Helper()
+	tokens, err := issuer.Login(context.Background(), model.TokenClaims{
+		TenantId: 7, ActorType: "ADMIN", AdminId: 1, GroupId: 3, PermissionVersion: 1, AdminPermissionVersion: 1,
+	}, "1.1.1.1", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+func TestSignAndParse(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	now := time.Unix(1685613600, 0)
+	signed, err := signer.Sign(model.TokenClaims{SessionId: "s1", AdminId: 1, TokenType: TypeAccess, ExpiredAt: now.Unix() + 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := signer.Parse(signed, now)
+	if err != nil || claims.SessionId != "s1" || claims.AdminId != 1 {
+		t.Fatalf("unexpected claims %+v %v", claims, err)
+	}
+	if _, err := signer.Parse(signed, now.Add(time.Minute)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected ErrExpired, got %v", err)
+	}
+	if _, err := NewSigner([]byte("other")).Parse(signed, now); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for another key, got %v", err)
+	}
+	parts := strings.Split(signed, ".")
+	if _, err := signer.Parse(parts[0]+"."+parts[1]+"x."+parts[2], now); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for a tampered payload, got %v", err)
+	}
+	if _, err := signer.Parse("eyJhbGciOiJub25lIn0."+parts[1]+".", now); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for alg none, got %v", err)
+	}
+}
+
+func TestLoginAndAuthenticate(t *testing.T) {
+	issuer, store, actor, _ := newTestIssuer(t)
+	tokens := login(t, issuer)
+
+	claims, err := issuer.Authenticate(context.Background(), tokens.Token, actor.versions)
+	if err != nil || claims.AdminId != 1 || claims.TenantId != 7 || claims.TokenType != TypeAccess {
+		t.Fatalf("unexpected claims %+v %v", claims, err)
+	}
+	session, _ := store.GetSession(context.Background(), claims.SessionId)
+	if session == nil || session.ActorId != 1 || strings.Contains(session.RefreshTokenHash, strings.Split(tokens.RefreshToken, ".")[1]) {
+		t.Fatalf("expected a session with a hashed refresh token, got %+v", session)
+	}
+	if _, err := issuer.Authenticate(context.Background(), tokens.RefreshToken, actor.versions); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("a refresh token must not authenticate, got %v", err)
+	}
+}
+
+func TestRefreshRotation(t *testing.T) {
+	issuer, _, actor, now := newTestIssuer(t)
+	first := login(t, issuer)
+
+	*now = now.Add(20 * time.Minute)
+	if _, err := issuer.Authenticate(context.Background(), first.Token, actor.versions); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected the access token to expire, got %v", err)
+	}
+	second, err := issuer.Refresh(context.Background(), first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("expected the refresh token to rotate")
+	}
+	if _, err := issuer.Authenticate(context.Background(), second.Token, actor.versions); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replaying the first refresh token means it leaked: the whole session is revoked.
+	if _, err := issuer.Refresh(context.Background(), first.RefreshToken); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("expected ErrRevoked on reuse, got %v", err)
+	}
+	if _, err := issuer.Refresh(context.Background(), second.RefreshToken); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("expected the session to be revoked, got %v", err)
+	}
+	if _, err := issuer.Authenticate(context.Background(), second.Token, actor.versions); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("expected access tokens of the session to stop working, got %v", err)
+	}
+}
+
+func TestStalePermissions(t *testing.T) {
+	issuer, _, actor, _ := newTestIssuer(t)
+	tokens := login(t, issuer)
+
+	actor.versions.PermissionVersion = 2
+	if _, err := issuer.Authenticate(context.Background(), tokens.Token, actor.versions); !errors.Is(err, ErrStale) {
+		t.Fatalf("expected ErrStale after a group change, got %v", err)
+	}
+	refreshed, err := issuer.Refresh(context.Background(), tokens.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.Claims.PermissionVersion != 2 {
+		t.Fatalf("expected the refreshed token to carry the new version, got %+v", refreshed.Claims)
+	}
+	if _, err := issuer.Authenticate(context.Background(), refreshed.Token, actor.versions); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForcedLogout(t *testing.T) {
+	issuer, store, actor, _ := newTestIssuer(t)
+	one := login(t, issuer)
+	two := login(t, issuer)
+
+	err := issuer.Revoke(context.Background(), model.SessionRevokeBody{ActorType: "ADMIN", ActorId: 1, RevokedReason: "STATUS_CHANGED", RevokedByUsername: "root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tokens := range []*Tokens{one, two} {
+		if _, err := issuer.Authenticate(context.Background(), tokens.Token, actor.versions); !errors.Is(err, ErrRevoked) {
+			t.Fatalf("expected ErrRevoked, got %v", err)
+		}
+	}
+	session, _ := store.GetSession(context.Background(), one.Claims.SessionId)
+	if session.RevokedReason != "STATUS_CHANGED" || session.RevokedByUsername != "root" {
+		t.Fatalf("unexpected session %+v", session)
+	}
+}
+
+func TestRefreshAfterDeactivation(t *testing.T) {
+	issuer, store, actor, _ := newTestIssuer(t)
+	tokens := login(t, issuer)
+
+	actor.status = "DEACTIVE"
+	if _, err := issuer.Refresh(context.Background(), tokens.RefreshToken); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("expected ErrRevoked for a deactivated admin, got %v", err)
+	}
+	session, _ := store.GetSession(context.Background(), tokens.Claims.SessionId)
+	if session.RevokedAt == nil {
+		t.Fatal("expected the session to be revoked")
+	}
+}
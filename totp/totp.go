@@ -0,0 +1,165 @@
+// Package totp implements RFC 6238 time based one-time passwords and recovery codes for AdminTotp.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+const (
+	Period = 30
+	Digits = 6
+	// Skew is how many steps before or after the current one are accepted, for clock drift.
+	Skew = 1
+)
+
+var ErrInvalidSecret = errors.New("totp: invalid secret")
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random 160 bit secret in unpadded base32, the form authenticator apps expect.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Enroll creates a secret and the otpauth URI to show as a QR code.
+func Enroll(issuer, account string) (model.TotpEnrollResponse, error) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		return model.TotpEnrollResponse{}, err
+	}
+	return model.TotpEnrollResponse{Secret: secret, ProvisioningUri: ProvisioningURI(issuer, account, secret)}, nil
+}
+
+// ProvisioningURI builds otpauth://totp/<issuer>:<account>?secret=...&issuer=... for authenticator apps.
+func ProvisioningURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(Period))
+	return "otpauth://totp/" + url.PathEscape(issuer) + ":" + url.PathEscape(account) + "?" + query.Encode()
+}
+
+// Step is the time step t falls in.
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Code returns the code for the step t falls in.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, Step(t)), nil
+}
+
+// Verify checks a code against the steps around t. A code is accepted once: steps at or before
+// lastUsedStep are rejected, so store the returned step in AdminTotp.LastUsedStep after a success.
+func Verify(secret, code string, t time.Time, lastUsedStep int64) (step int64, ok bool, err error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false, nil
+	}
+	current := Step(t)
+	for s := current - Skew; s <= current+Skew; s++ {
+		if s <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(hotp(key, s)), []byte(code)) == 1 {
+			return s, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// recoveryAlphabet leaves out 0, 1, i, l and o, which are easy to misread.
+const recoveryAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// GenerateRecoveryCodes returns n codes like "k7m2q-x9d4r". Show them once and store HashRecoveryCode.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	// Bytes from 248 up are skipped so every character is equally likely.
+	limit := byte(256 / len(recoveryAlphabet) * len(recoveryAlphabet))
+	codes := make([]string, n)
+	b := make([]byte, 1)
+	for i := range codes {
+		var code strings.Builder
+		for code.Len() < 11 {
+			if code.Len() == 5 {
+				code.WriteByte('-')
+				continue
+			}
+			if _, err := rand.Read(b); err != nil {
+				return nil, err
+			}
+			if b[0] < limit {
+				code.WriteByte(recoveryAlphabet[int(b[0])%len(recoveryAlphabet)])
+			}
+		}
+		codes[i] = code.String()
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode is the value stored in AdminRecoveryCode.CodeHash. Codes are random, so a plain
+// SHA-256 is enough; case, spaces and dashes are ignored.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchRecoveryCode returns the index of the unused row the code belongs to, or -1. Set UsedAt on it.
+func MatchRecoveryCode(code string, rows []model.AdminRecoveryCode) int {
+	hash := []byte(HashRecoveryCode(code))
+	match := -1
+	for i, row := range rows {
+		if row.UsedAt == nil && subtle.ConstantTimeCompare(hash, []byte(row.CodeHash)) == 1 {
+			match = i
+		}
+	}
+	return match
+}
@@ -0,0 +1,107 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Cyber-Rich-Digital/game-package/model"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFC6238(t *testing.T) {
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		got, err := Code(rfcSecret, time.Unix(c.unix, 0))
+		if err != nil || got != c.code {
+			t.Errorf("Code at %d = %s %v, want %s", c.unix, got, err, c.code)
+		}
+	}
+}
+
+func TestVerifyWindowAndReplay(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	step, ok, err := Verify(rfcSecret, "081804", now, 0)
+	if err != nil || !ok || step != Step(now) {
+		t.Fatalf("expected the current code to verify, got %d %v %v", step, ok, err)
+	}
+	if _, ok, _ := Verify(rfcSecret, "081804", now, step); ok {
+		t.Fatal("a code must not be accepted twice")
+	}
+	if _, ok, _ := Verify(rfcSecret, "081804", now.Add(Period*time.Second), 0); !ok {
+		t.Fatal("expected the previous step to be accepted for clock drift")
+	}
+	if _, ok, _ := Verify(rfcSecret, "081804", now.Add(2*Period*time.Second), 0); ok {
+		t.Fatal("expected a code two steps old to be rejected")
+	}
+	if _, ok, _ := Verify(rfcSecret, "123456", now, 0); ok {
+		t.Fatal("expected a wrong code to be rejected")
+	}
+	if _, _, err := Verify("not base32!", "123456", now, 0); err != ErrInvalidSecret {
+		t.Fatalf("expected ErrInvalidSecret, got %v", err)
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	res, err := Enroll("Backoffice", "admin01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Secret) != 32 {
+		t.Fatalf("expected a 160 bit secret, got %q", res.Secret)
+	}
+	code, err := Code(res.Secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := Verify(res.Secret, code, time.Now(), 0); !ok {
+		t.Fatal("expected the enrolled secret to verify its own code")
+	}
+	want := "otpauth://totp/Backoffice:admin01?algorithm=SHA1&digits=6&issuer=Backoffice&period=30&secret=" + res.Secret
+	if res.ProvisioningUri != want {
+		t.Fatalf("unexpected uri %s", res.ProvisioningUri)
+	}
+	if uri := ProvisioningURI("My Site", "a b", "ABC"); !strings.HasPrefix(uri, "otpauth://totp/My%20Site:a%20b?") {
+		t.Fatalf("expected the label to be escaped, got %s", uri)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	rows := make([]model.AdminRecoveryCode, len(codes))
+	for i, code := range codes {
+		if len(code) != 11 || code[5] != '-' || seen[code] {
+			t.Fatalf("unexpected code %q", code)
+		}
+		seen[code] = true
+		rows[i] = model.AdminRecoveryCode{Id: int64(i + 1), CodeHash: HashRecoveryCode(code)}
+	}
+
+	if i := MatchRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[3], "-", " ")), rows); i != 3 {
+		t.Fatalf("expected the code to match row 3 ignoring case and separators, got %d", i)
+	}
+	now := time.Now()
+	rows[3].UsedAt = &now
+	if i := MatchRecoveryCode(codes[3], rows); i != -1 {
+		t.Fatalf("a used code must not match, got %d", i)
+	}
+	if i := MatchRecoveryCode("aaaaa-aaaaa", rows); i != -1 {
+		t.Fatalf("an unknown code must not match, got %d", i)
+	}
+}